@@ -0,0 +1,431 @@
+package main
+
+import (
+	"crypto/md5"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Mrs4s/MiraiGo/binary"
+	"github.com/Mrs4s/MiraiGo/client"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
+	"github.com/Mrs4s/go-cqhttp/global/state"
+	"github.com/Mrs4s/go-cqhttp/server"
+)
+
+// account 一个账号的配置与登录状态
+type account struct {
+	conf   *config.Config
+	states state.Store // 会话与设备信息等运行状态的存储后端
+	device *client.DeviceInfo
+	cli    *client.QQClient
+
+	passwordHash  [16]byte // QQ密码哈希
+	token         []byte   // 会话缓存, 供重连使用
+	isQRCodeLogin bool
+	broker        *server.LoginBroker
+
+	bot     *coolq.CQBot
+	servers *server.Manager // 仅服务于该账号的通信服务, 单账号模式下为 nil
+}
+
+// defaultDevice 生成随机设备时使用的默认设备信息
+var defaultDevice = *client.SystemDeviceInfo
+
+// loginLock 登录流程使用全局的 cli, loginBroker 与 client.SystemDeviceInfo,
+// 多个账号需依次登录
+var loginLock sync.Mutex
+
+// loadAccounts 根据配置文件创建需要登录的账号
+//
+// 未配置 accounts 时使用 account 中的单个账号, 否则使用 accounts 中的全部账号,
+// 此时每个账号的数据与运行状态分别保存在以 QQ 号命名的目录中.
+func loadAccounts(conf *config.Config) []*account {
+	if len(conf.Accounts) == 0 {
+		return []*account{{conf: conf}}
+	}
+	accounts := make([]*account, 0, len(conf.Accounts))
+	seen := make(map[int64]bool)
+	for i := range conf.Accounts {
+		ac := conf.ForAccount(&conf.Accounts[i])
+		if ac.Account.Uin == 0 {
+			log.Fatalf("多账号模式下 accounts 中的每个账号都必须设置 uin.")
+		}
+		if seen[ac.Account.Uin] {
+			log.Fatalf("accounts 中的账号 %v 重复.", ac.Account.Uin)
+		}
+		seen[ac.Account.Uin] = true
+		uin := strconv.FormatInt(ac.Account.Uin, 10)
+		if ac.Account.DataDir == "" {
+			ac.Account.DataDir = path.Join(global.DataPath, "accounts", uin)
+		}
+		switch strings.ToLower(ac.State.Type) {
+		case "", "file":
+			if ac.State.Dir == "" {
+				ac.State.Dir = ac.Account.DataDir
+			} else {
+				ac.State.Dir = path.Join(ac.State.Dir, uin)
+			}
+		case "env":
+			if ac.State.EnvPrefix == "" {
+				ac.State.EnvPrefix = "GCQ_STATE_"
+			}
+			ac.State.EnvPrefix += uin + "_"
+		case "s3":
+			ac.State.S3.Prefix += uin + "/"
+		}
+		accounts = append(accounts, &account{conf: ac})
+	}
+	return accounts
+}
+
+// withClient 在持有 loginLock 时以该账号的客户端执行 login.go 中的登录流程
+func (a *account) withClient(fn func() error) error {
+	loginLock.Lock()
+	defer loginLock.Unlock()
+	cli, loginBroker, client.SystemDeviceInfo = a.cli, a.broker, a.device
+	err := fn()
+	a.cli = cli // 登录流程可能会重新创建客户端
+	return err
+}
+
+// prepare 读取设备信息与密码, serverless 不为 nil 时要求可以恢复会话
+func (a *account) prepare(serverless *config.ServerlessServer) {
+	var err error
+	ac := &a.conf.Account
+	a.states, err = state.New(&a.conf.State)
+	if err != nil {
+		log.Fatalf("初始化状态存储失败: %v", err)
+	}
+	if (ac.Uin == 0 || (ac.Password == "" && !ac.Encrypt)) && !state.Exists(a.states, "session.token") {
+		log.Warn("账号密码未配置, 将使用二维码登录.")
+		if !isFastStart {
+			log.Warn("将在 5秒 后继续.")
+			time.Sleep(time.Second * 5)
+		}
+	}
+	if serverless != nil && (!state.Exists(a.states, "device.json") || !state.Exists(a.states, "session.token")) {
+		log.Fatalf("Serverless 模式需要 device.json 与 session.token 恢复会话, 请先在本地完成登录.")
+	}
+
+	// 每个账号使用独立的设备信息, 生成随机设备时会修改 AndroidId 的内容
+	device := defaultDevice
+	device.AndroidId = append([]byte(nil), device.AndroidId...)
+	a.device = &device
+	loginLock.Lock()
+	client.SystemDeviceInfo = a.device
+	if data, err := a.states.Load("device.json"); err != nil {
+		if err != state.ErrNotExist {
+			log.Fatalf("读取设备信息失败: %v", err)
+		}
+		log.Warn("虚拟设备信息不存在, 将自动生成随机设备.")
+		client.GenRandomDevice()
+		if err = a.states.Save("device.json", a.device.ToJson()); err != nil {
+			log.Warnf("保存设备信息失败: %v", err)
+		}
+		log.Info("已生成设备信息并保存到 device.json 文件.")
+	} else {
+		log.Info("将使用 device.json 内的设备信息运行Bot.")
+		if err := a.device.ReadJson(data); err != nil {
+			log.Fatalf("加载设备信息失败: %v", err)
+		}
+	}
+	loginLock.Unlock()
+
+	if !ac.Encrypt {
+		a.passwordHash = md5.Sum([]byte(ac.Password))
+		return
+	}
+	if !state.Exists(a.states, "password.encrypt") {
+		if ac.Password == "" {
+			log.Error("无法进行加密，请在配置文件中的添加密码后重新启动.")
+			readLine()
+			os.Exit(0)
+		}
+		log.Infof("密码加密已启用, 请输入Key对密码进行加密: (Enter 提交)")
+		byteKey, _ = term.ReadPassword(int(os.Stdin.Fd()))
+		a.passwordHash = md5.Sum([]byte(ac.Password))
+		_ = a.states.Save("password.encrypt", []byte(PasswordHashEncrypt(a.passwordHash[:], byteKey)))
+		log.Info("密码已加密，为了您的账号安全，请删除配置文件中的密码后重新启动.")
+		readLine()
+		os.Exit(0)
+	}
+	if ac.Password != "" {
+		log.Error("密码已加密，为了您的账号安全，请删除配置文件中的密码后重新启动.")
+		readLine()
+		os.Exit(0)
+	}
+	if len(byteKey) == 0 {
+		log.Infof("密码加密已启用, 请输入Key对密码进行解密以继续: (Enter 提交)")
+		cancel := make(chan struct{}, 1)
+		state, _ := term.GetState(int(os.Stdin.Fd()))
+		go func() {
+			select {
+			case <-cancel:
+				return
+			case <-time.After(time.Second * 45):
+				log.Infof("解密key输入超时")
+				time.Sleep(3 * time.Second)
+				_ = term.Restore(int(os.Stdin.Fd()), state)
+				os.Exit(0)
+			}
+		}()
+		byteKey, _ = term.ReadPassword(int(os.Stdin.Fd()))
+		cancel <- struct{}{}
+	} else {
+		log.Infof("密码加密已启用, 使用运行时传递的参数进行解密，按 Ctrl+C 取消.")
+	}
+	encrypt, _ := a.states.Load("password.encrypt")
+	ph, err := PasswordHashDecrypt(string(encrypt), byteKey)
+	if err != nil {
+		log.Fatalf("加密存储的密码损坏，请尝试重新配置密码")
+	}
+	copy(a.passwordHash[:], ph)
+}
+
+// login 登录账号并创建 Bot 实例, 登录失败时退出
+func (a *account) login(serverless *config.ServerlessServer) {
+	ac := &a.conf.Account
+	log.Infof("使用协议: %v", func() string {
+		switch a.device.Protocol {
+		case client.IPad:
+			return "iPad"
+		case client.AndroidPhone:
+			return "Android Phone"
+		case client.AndroidWatch:
+			return "Android Watch"
+		case client.MacOS:
+			return "MacOS"
+		case client.QiDian:
+			return "企点"
+		}
+		return "未知"
+	}())
+	loginLock.Lock()
+	client.SystemDeviceInfo = a.device
+	a.cli = client.NewClientEmpty()
+	loginLock.Unlock()
+	if ac.Uin != 0 && a.passwordHash != [16]byte{} {
+		a.cli.Uin = ac.Uin
+		a.cli.PasswordMd5 = a.passwordHash
+	}
+	a.cli.OnLog(func(c *client.QQClient, e *client.LogEvent) {
+		switch e.Type {
+		case "INFO":
+			log.Info("Protocol -> " + e.Message)
+		case "ERROR":
+			log.Error("Protocol -> " + e.Message)
+		case "DEBUG":
+			log.Debug("Protocol -> " + e.Message)
+		}
+	})
+	if data, err := a.states.Load("address.txt"); err == nil {
+		log.Infof("检测到 address.txt 文件. 将覆盖目标IP.")
+		addr := global.ParseAddrs(data)
+		if len(addr) > 0 {
+			a.cli.SetCustomServer(addr)
+		}
+		log.Infof("读取到 %v 个自定义地址.", len(addr))
+	}
+	a.cli.OnServerUpdated(func(bot *client.QQClient, e *client.ServerUpdatedEvent) bool {
+		if !ac.UseSSOAddress {
+			log.Infof("收到服务器地址更新通知, 根据配置文件已忽略.")
+			return false
+		}
+		log.Infof("收到服务器地址更新通知, 将在下一次重连时应用. ")
+		return true
+	})
+	a.isQRCodeLogin = (ac.Uin == 0 || len(ac.Password) == 0) && !ac.Encrypt
+	isTokenLogin := false
+	if token, err := a.states.Load("session.token"); err == nil {
+		if ac.Uin != 0 {
+			r := binary.NewReader(token)
+			cu := r.ReadInt64()
+			if cu != ac.Uin {
+				log.Warnf("警告: 配置文件内的QQ号 (%v) 与缓存内的QQ号 (%v) 不相同", ac.Uin, cu)
+				log.Warnf("1. 使用会话缓存继续.")
+				log.Warnf("2. 删除会话缓存并重启.")
+				log.Warnf("请选择: (5秒后自动选1)")
+				text := readLineTimeout(time.Second*5, "1")
+				if text == "2" {
+					_ = a.states.Delete("session.token")
+					os.Exit(0)
+				}
+			}
+		}
+		if err = a.withClient(func() error { return a.cli.TokenLogin(token) }); err != nil {
+			_ = a.states.Delete("session.token")
+			log.Warnf("恢复会话失败: %v , 尝试使用正常流程登录.", err)
+			time.Sleep(time.Second)
+		} else {
+			isTokenLogin = true
+		}
+	}
+	stopLoginBroker := func() {}
+	if ac.LoginBroker.Enabled && !isTokenLogin {
+		stopLoginBroker = a.setupLoginBroker()
+	}
+	if !isTokenLogin && serverless != nil {
+		log.Fatalf("Serverless 模式下恢复会话失败, 请在本地重新登录后更新 session.token.")
+	}
+	if !isTokenLogin {
+		login := commonLogin
+		if a.isQRCodeLogin {
+			login = qrcodeLogin
+		}
+		if err := a.withClient(login); err != nil {
+			log.Fatalf("登录时发生致命错误: %v", err)
+		}
+	}
+	stopLoginBroker()
+	a.broker = nil
+	var times uint = 1 // 重试次数
+	var reLoginLock sync.Mutex
+	a.cli.OnDisconnected(func(q *client.QQClient, e *client.ClientDisconnectedEvent) {
+		reLoginLock.Lock()
+		defer reLoginLock.Unlock()
+		times = 1
+		if a.cli.Online {
+			return
+		}
+		log.Warnf("Bot %v 已离线: %v", q.Uin, e.Message)
+		time.Sleep(time.Second * time.Duration(ac.ReLogin.Delay))
+		for {
+			if ac.ReLogin.Disabled {
+				a.stop("Bot %v 已离线, 未启用自动重连", q.Uin)
+				return
+			}
+			if times > ac.ReLogin.MaxTimes && ac.ReLogin.MaxTimes != 0 {
+				a.stop("Bot %v 重连次数超过限制, 停止", q.Uin)
+				return
+			}
+			times++
+			if ac.ReLogin.Interval > 0 {
+				log.Warnf("将在 %v 秒后尝试重连. 重连次数：%v/%v", ac.ReLogin.Interval, times, ac.ReLogin.MaxTimes)
+				time.Sleep(time.Second * time.Duration(ac.ReLogin.Interval))
+			} else {
+				time.Sleep(time.Second)
+			}
+			log.Warnf("尝试重连...")
+			err := a.withClient(func() error { return a.cli.TokenLogin(a.token) })
+			if err == nil {
+				metrics.ReconnectAttempts.Inc("success")
+				a.saveToken()
+				return
+			}
+			log.Warnf("快速重连失败: %v", err)
+			if a.isQRCodeLogin {
+				a.stop("Bot %v 快速重连失败, 扫码登录无法恢复会话.", q.Uin)
+				return
+			}
+			log.Warnf("快速重连失败, 尝试普通登录. 这可能是因为其他端强行T下线导致的.")
+			time.Sleep(time.Second)
+			if err := a.withClient(commonLogin); err != nil {
+				metrics.ReconnectAttempts.Inc("failed")
+				log.Errorf("登录时发生致命错误: %v", err)
+			} else {
+				metrics.ReconnectAttempts.Inc("success")
+				a.saveToken()
+				break
+			}
+		}
+	})
+	a.saveToken()
+	a.cli.AllowSlider = true
+	log.Infof("登录成功 欢迎使用: %v", a.cli.Nickname)
+	log.Info("开始加载好友列表...")
+	a.checkSession(a.cli.ReloadFriendList())
+	log.Infof("共加载 %v 个好友.", len(a.cli.FriendList))
+	log.Infof("开始加载群列表...")
+	a.checkSession(a.cli.ReloadGroupList())
+	log.Infof("共加载 %v 个群.", len(a.cli.GroupList))
+	if ac.Status >= int32(len(allowStatus)) || ac.Status < 0 {
+		ac.Status = 0
+	}
+	a.cli.SetOnlineStatus(allowStatus[int(ac.Status)])
+	a.bot = coolq.NewQQBot(a.cli, a.conf)
+	server.RegisterBot(a.bot)
+}
+
+// stop 停止无法恢复的账号并释放其 Bot 与通信服务, 其他账号不受影响, 所有账号均已停止时退出
+func (a *account) stop(format string, args ...interface{}) {
+	log.Errorf(format, args...)
+	if removeAccount(a) == 0 {
+		log.Error("所有账号均已停止, 程序退出.")
+		closeLogSinks()
+		os.Exit(1)
+	}
+	if a.servers != nil {
+		a.servers.Close()
+	}
+	if a.bot != nil {
+		server.UnregisterBot(a.bot)
+		a.bot.Release()
+	}
+	a.cli.Release()
+}
+
+// saveToken 保存会话缓存
+func (a *account) saveToken() {
+	a.token = a.cli.GenToken()
+	if err := a.states.Save("session.token", a.token); err != nil {
+		log.Warnf("保存会话缓存失败: %v", err)
+	}
+}
+
+// checkSession 检测err是否为nil, 不为nil时删除会话缓存并退出
+func (a *account) checkSession(err error) {
+	if err != nil {
+		_ = a.states.Delete("session.token")
+		log.Fatalf("遇到错误: %v", err)
+	}
+}
+
+// setupLoginBroker 初始化登录验证代理, 并在登录完成前启动用于提交验证结果的 HTTP 服务器与管理 API 服务器
+//
+// 多账号模式下同时使用账号自身与全局的 HTTP 配置. 返回的函数用于关闭这些临时服务器
+func (a *account) setupLoginBroker() func() {
+	var (
+		posts []config.HTTPPost
+		stops []func()
+	)
+	list := a.conf.Servers
+	if c := currentConfig(); len(c.Accounts) > 0 {
+		list = append(append([]map[string]yaml.Node(nil), list...), c.Servers...)
+	}
+	for _, m := range list {
+		if h, ok := m["http"]; ok {
+			hc := new(config.HTTPServer)
+			if err := h.Decode(hc); err != nil || hc.Disabled {
+				continue
+			}
+			posts = append(posts, hc.Post...)
+			stops = append(stops, server.RunLoginBrokerServer(hc))
+		}
+		if n, ok := m["admin"]; ok {
+			ac := new(config.AdminServer)
+			if err := n.Decode(ac); err == nil {
+				if stop := server.RunAdminServer(ac); stop != nil {
+					stops = append(stops, stop)
+				}
+			}
+		}
+	}
+	a.broker = server.NewLoginBroker(a.conf.Account.Uin, posts, time.Second*time.Duration(a.conf.Account.LoginBroker.Timeout))
+	log.Info("登录验证代理已启用, 登录验证将推送至 HTTP POST 上报地址.")
+	return func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}
+}
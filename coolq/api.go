@@ -351,7 +351,7 @@ func (bot *CQBot) CQGetWordSlices(content string) MSG {
 // CQSendGroupMessage 发送群消息
 //
 // https://git.io/Jtz1c
-func (bot *CQBot) CQSendGroupMessage(groupID int64, i interface{}, autoEscape bool) MSG {
+func (bot *CQBot) CQSendGroupMessage(groupID int64, i interface{}, autoEscape bool, priority SendPriority) MSG {
 	var str string
 	group := bot.Client.FindGroup(groupID)
 	if group == nil {
@@ -372,11 +372,17 @@ func (bot *CQBot) CQSendGroupMessage(groupID int64, i interface{}, autoEscape bo
 		if m.Type == gjson.JSON {
 			elem := bot.ConvertObjectMessage(m, true)
 			fixAt(elem)
-			mid := bot.SendGroupMessage(groupID, &message.SendingMessage{Elements: elem})
+			mid, queued := bot.sendQueued("group", groupID, priority, func() int32 {
+				return bot.SendGroupMessage(groupID, &message.SendingMessage{Elements: elem})
+			})
+			if queued != nil {
+				return queued
+			}
 			if mid == -1 {
 				return Failed(100, "SEND_MSG_API_ERROR", "请参考 go-cqhttp 端输出")
 			}
-			log.Infof("发送群 %v(%v) 的消息: %v (%v)", group.Name, groupID, limitedString(ToStringMessage(elem, groupID)), mid)
+			bot.logWith(log.Fields{"group_id": groupID, "message_id": mid}).
+				Infof("发送群 %v(%v) 的消息: %v (%v)", group.Name, groupID, limitedString(ToStringMessage(elem, groupID)), mid)
 			return OK(MSG{"message_id": mid})
 		}
 		str = func() string {
@@ -399,11 +405,17 @@ func (bot *CQBot) CQSendGroupMessage(groupID int64, i interface{}, autoEscape bo
 		elem = bot.ConvertStringMessage(str, true)
 	}
 	fixAt(elem)
-	mid := bot.SendGroupMessage(groupID, &message.SendingMessage{Elements: elem})
+	mid, queued := bot.sendQueued("group", groupID, priority, func() int32 {
+		return bot.SendGroupMessage(groupID, &message.SendingMessage{Elements: elem})
+	})
+	if queued != nil {
+		return queued
+	}
 	if mid == -1 {
 		return Failed(100, "SEND_MSG_API_ERROR", "请参考 go-cqhttp 端输出")
 	}
-	log.Infof("发送群 %v(%v) 的消息: %v (%v)", group.Name, groupID, limitedString(str), mid)
+	bot.logWith(log.Fields{"group_id": groupID, "message_id": mid}).
+		Infof("发送群 %v(%v) 的消息: %v (%v)", group.Name, groupID, limitedString(str), mid)
 	return OK(MSG{"message_id": mid})
 }
 
@@ -532,16 +544,22 @@ func (bot *CQBot) CQSendGroupForwardMessage(groupID int64, m gjson.Result) MSG {
 // CQSendPrivateMessage 发送私聊消息
 //
 // https://git.io/Jtz1l
-func (bot *CQBot) CQSendPrivateMessage(userID int64, groupID int64, i interface{}, autoEscape bool) MSG {
+func (bot *CQBot) CQSendPrivateMessage(userID int64, groupID int64, i interface{}, autoEscape bool, priority SendPriority) MSG {
 	var str string
 	if m, ok := i.(gjson.Result); ok {
 		if m.Type == gjson.JSON {
 			elem := bot.ConvertObjectMessage(m, false)
-			mid := bot.SendPrivateMessage(userID, groupID, &message.SendingMessage{Elements: elem})
+			mid, queued := bot.sendQueued("private", userID, priority, func() int32 {
+				return bot.SendPrivateMessage(userID, groupID, &message.SendingMessage{Elements: elem})
+			})
+			if queued != nil {
+				return queued
+			}
 			if mid == -1 {
 				return Failed(100, "SEND_MSG_API_ERROR", "请参考 go-cqhttp 端输出")
 			}
-			log.Infof("发送好友 %v(%v)  的消息: %v (%v)", userID, userID, limitedString(m.String()), mid)
+			bot.logWith(log.Fields{"user_id": userID, "message_id": mid}).
+				Infof("发送好友 %v(%v)  的消息: %v (%v)", userID, userID, limitedString(m.String()), mid)
 			return OK(MSG{"message_id": mid})
 		}
 		str = func() string {
@@ -562,11 +580,17 @@ func (bot *CQBot) CQSendPrivateMessage(userID int64, groupID int64, i interface{
 	} else {
 		elem = bot.ConvertStringMessage(str, false)
 	}
-	mid := bot.SendPrivateMessage(userID, groupID, &message.SendingMessage{Elements: elem})
+	mid, queued := bot.sendQueued("private", userID, priority, func() int32 {
+		return bot.SendPrivateMessage(userID, groupID, &message.SendingMessage{Elements: elem})
+	})
+	if queued != nil {
+		return queued
+	}
 	if mid == -1 {
 		return Failed(100, "SEND_MSG_API_ERROR", "请参考 go-cqhttp 端输出")
 	}
-	log.Infof("发送好友 %v(%v)  的消息: %v (%v)", userID, userID, limitedString(str), mid)
+	bot.logWith(log.Fields{"user_id": userID, "message_id": mid}).
+		Infof("发送好友 %v(%v)  的消息: %v (%v)", userID, userID, limitedString(str), mid)
 	return OK(MSG{"message_id": mid})
 }
 
@@ -974,10 +998,10 @@ func (bot *CQBot) CQHandleQuickOperation(context, operation gjson.Result) MSG {
 			}
 
 			if msgType == "group" {
-				bot.CQSendGroupMessage(context.Get("group_id").Int(), reply, autoEscape)
+				bot.CQSendGroupMessage(context.Get("group_id").Int(), reply, autoEscape, PriorityNormal)
 			}
 			if msgType == "private" {
-				bot.CQSendPrivateMessage(context.Get("user_id").Int(), context.Get("group_id").Int(), reply, autoEscape)
+				bot.CQSendPrivateMessage(context.Get("user_id").Int(), context.Get("group_id").Int(), reply, autoEscape, PriorityNormal)
 			}
 		}
 		if msgType == "group" {
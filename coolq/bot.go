@@ -2,29 +2,30 @@ package coolq
 
 import (
 	"bytes"
-	"encoding/gob"
 	"encoding/hex"
 	"fmt"
 	"hash/crc32"
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"runtime/debug"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/client"
 	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/utils"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/Mrs4s/go-cqhttp/global"
 	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -33,10 +34,14 @@ var json = jsoniter.ConfigCompatibleWithStandardLibrary
 type CQBot struct {
 	Client *client.QQClient
 
-	lock   sync.RWMutex
-	events []func(*Event)
+	dataDir string
+	lock    sync.RWMutex
+	events  []*eventHandler
+	pending int32 // 正在分发的事件数
 
-	db               *leveldb.DB
+	db               MessageStore
+	journal          *journal
+	sendQueue        *sendQueue
 	friendReqCache   sync.Map
 	tempSessionCache sync.Map
 	oneWayMsgCache   sync.Map
@@ -79,26 +84,18 @@ var ForceFragmented = false
 // NewQQBot 初始化一个QQBot实例
 func NewQQBot(cli *client.QQClient, conf *config.Config) *CQBot {
 	bot := &CQBot{
-		Client: cli,
-	}
-	enableLevelDB := false
-	node, ok := conf.Database["leveldb"]
-	if ok {
-		lconf := new(config.LevelDBConfig)
-		_ = node.Decode(lconf)
-		enableLevelDB = lconf.Enable
-	}
-	if enableLevelDB {
-		p := path.Join("/mnt/data", "leveldb")
-		db, err := leveldb.OpenFile(p, &opt.Options{
-			WriteBuffer: 128 * opt.KiB,
-		})
-		if err != nil {
-			log.Fatalf("打开数据库失败, 如果频繁遇到此问题请清理 /mnt/data/leveldb 文件夹或关闭数据库功能。")
-		}
+		Client:  cli,
+		dataDir: conf.Account.DataPath(),
+	}
+	bot.sendQueue = newSendQueue(&conf.Message.SendQueue, bot.sendResultEvent)
+	db, err := NewMessageStore(conf)
+	if err != nil {
+		log.Fatalf("打开数据库失败, 如果频繁遇到此问题请清理数据库文件或关闭数据库功能: %v", err)
+	}
+	if db != nil {
 		bot.db = db
-		gob.Register(message.Sender{})
 		log.Info("信息数据库初始化完成.")
+		bot.journal = openJournal(conf, db)
 	} else {
 		log.Warn("警告: 信息数据库已关闭，将无法使用 [回复/撤回] 等功能。")
 	}
@@ -152,23 +149,36 @@ func NewQQBot(cli *client.QQClient, conf *config.Config) *CQBot {
 	return bot
 }
 
-// OnEventPush 注册事件上报函数
-func (bot *CQBot) OnEventPush(f func(e *Event)) {
+// eventHandler 已注册的事件上报函数
+type eventHandler struct {
+	fn   func(*Event)
+	name string // 上报函数名称, 用于耗时统计
+}
+
+// OnEventPush 注册事件上报函数, 返回的函数用于取消注册
+func (bot *CQBot) OnEventPush(f func(e *Event)) (remove func()) {
+	h := &eventHandler{fn: f, name: handlerName(f)}
 	bot.lock.Lock()
-	bot.events = append(bot.events, f)
+	bot.events = append(bot.events, h)
 	bot.lock.Unlock()
+	return func() {
+		bot.lock.Lock()
+		defer bot.lock.Unlock()
+		for i, e := range bot.events {
+			if e == h {
+				bot.events = append(bot.events[:i:i], bot.events[i+1:]...)
+				return
+			}
+		}
+	}
 }
 
 // GetMessage 获取给定消息id对应的消息
 func (bot *CQBot) GetMessage(mid int32) MSG {
 	if bot.db != nil {
-		m := MSG{}
-		data, err := bot.db.Get(binary.ToBytes(mid), nil)
+		m, err := bot.db.Get(mid)
 		if err == nil {
-			err = gob.NewDecoder(bytes.NewReader(data)).Decode(&m)
-			if err == nil {
-				return m
-			}
+			return m
 		}
 		log.Warnf("获取信息时出现错误: %v id: %v", err, mid)
 	}
@@ -273,6 +283,7 @@ func (bot *CQBot) SendGroupMessage(groupID int64, m *message.SendingMessage) int
 	ret := bot.Client.SendGroupMessage(groupID, m, ForceFragmented)
 	if ret == nil || ret.Id == -1 {
 		log.Warnf("群消息发送失败: 账号可能被风控.")
+		bot.sendQueue.riskControlled()
 		return -1
 	}
 	return bot.InsertGroupMessage(ret)
@@ -306,7 +317,10 @@ func (bot *CQBot) SendPrivateMessage(target int64, groupID int64, m *message.Sen
 	m.Elements = newElem
 	bot.checkMedia(newElem)
 	var id int32 = -1
+	// attempted 是否已向服务器发送消息, 用于区分风控与参数错误
+	attempted := false
 	if bot.Client.FindFriend(target) != nil { // 双向好友
+		attempted = true
 		msg := bot.Client.SendPrivateMessage(target, m)
 		if msg != nil {
 			id = bot.InsertPrivateMessage(msg)
@@ -320,6 +334,7 @@ func (bot *CQBot) SendPrivateMessage(target int64, groupID int64, m *message.Sen
 		case groupID != 0 && bot.Client.FindGroup(groupID).FindMember(target) == nil:
 			log.Errorf("错误: 群员(%v) 不在 群(%v), 无法发起临时会话", target, groupID)
 		default:
+			attempted = true
 			if session == nil && groupID != 0 {
 				msg := bot.Client.SendGroupTempMessage(groupID, target, m)
 				if msg != nil {
@@ -337,6 +352,7 @@ func (bot *CQBot) SendPrivateMessage(target int64, groupID int64, m *message.Sen
 			}
 		}
 	} else if _, ok := bot.oneWayMsgCache.Load(target); ok { // 单向好友
+		attempted = true
 		msg := bot.Client.SendPrivateMessage(target, m)
 		if msg != nil {
 			id = bot.InsertPrivateMessage(msg)
@@ -347,6 +363,11 @@ func (bot *CQBot) SendPrivateMessage(target int64, groupID int64, m *message.Sen
 			nickname = summaryInfo.Nickname
 		}
 		log.Errorf("错误: 请先添加 %v(%v) 为好友", nickname, target)
+		return id
+	}
+	if id == -1 && attempted {
+		log.Warnf("私聊消息发送失败: 账号可能被风控.")
+		bot.sendQueue.riskControlled()
 	}
 	return id
 }
@@ -362,20 +383,7 @@ func (bot *CQBot) InsertGroupMessage(m *message.GroupMessage) int32 {
 		"time":        m.Time,
 		"message":     ToStringMessage(m.Elements, m.GroupCode, true),
 	}
-	id := toGlobalID(m.GroupCode, m.Id)
-	if bot.db != nil {
-		buf := global.NewBuffer()
-		defer global.PutBuffer(buf)
-		if err := gob.NewEncoder(buf).Encode(val); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-		if err := bot.db.Put(binary.ToBytes(id), buf.Bytes(), nil); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-	}
-	return id
+	return bot.insertMessage(toGlobalID(m.GroupCode, m.Id), val)
 }
 
 // InsertPrivateMessage 私聊消息入数据库
@@ -388,20 +396,7 @@ func (bot *CQBot) InsertPrivateMessage(m *message.PrivateMessage) int32 {
 		"time":        m.Time,
 		"message":     ToStringMessage(m.Elements, 0, true),
 	}
-	id := toGlobalID(m.Sender.Uin, m.Id)
-	if bot.db != nil {
-		buf := global.NewBuffer()
-		defer global.PutBuffer(buf)
-		if err := gob.NewEncoder(buf).Encode(val); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-		if err := bot.db.Put(binary.ToBytes(id), buf.Bytes(), nil); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-	}
-	return id
+	return bot.insertMessage(toGlobalID(m.Sender.Uin, m.Id), val)
 }
 
 // InsertTempMessage 临时消息入数据库
@@ -416,20 +411,7 @@ func (bot *CQBot) InsertTempMessage(target int64, m *message.TempMessage) int32
 		"time":       int32(time.Now().Unix()),
 		"message":    ToStringMessage(m.Elements, 0, true),
 	}
-	id := toGlobalID(m.Sender.Uin, m.Id)
-	if bot.db != nil {
-		buf := global.NewBuffer()
-		defer global.PutBuffer(buf)
-		if err := gob.NewEncoder(buf).Encode(val); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-		if err := bot.db.Put(binary.ToBytes(id), buf.Bytes(), nil); err != nil {
-			log.Warnf("记录聊天数据时出现错误: %v", err)
-			return -1
-		}
-	}
-	return id
+	return bot.insertMessage(toGlobalID(m.Sender.Uin, m.Id), val)
 }
 
 // toGlobalID 构建`code`-`msgID`的字符串并返回其CRC32 Checksum的值
@@ -437,22 +419,71 @@ func toGlobalID(code int64, msgID int32) int32 {
 	return int32(crc32.ChecksumIEEE([]byte(fmt.Sprintf("%d-%d", code, msgID))))
 }
 
+// logWith 返回附带 self_id 与 fields 的日志记录器, 字段仅在 json 格式的日志中输出
+func (bot *CQBot) logWith(fields log.Fields) *log.Entry {
+	fields["self_id"] = bot.Client.Uin
+	return log.WithFields(fields)
+}
+
+// DataDir 返回账号的数据目录
+func (bot *CQBot) DataDir() string {
+	return bot.dataDir
+}
+
 // Release 释放Bot实例
 func (bot *CQBot) Release() {
+	bot.sendQueue.close()
+	if bot.journal != nil {
+		bot.journal.close()
+	}
 	if bot.db != nil {
 		_ = bot.db.Close()
 	}
 }
 
+// PushLifecycle 推送生命周期元事件, subType 可为 enable, disable 与 connect
+func (bot *CQBot) PushLifecycle(subType string) {
+	bot.dispatchEventMessage(MSG{
+		"time":            time.Now().Unix(),
+		"self_id":         bot.Client.Uin,
+		"post_type":       "meta_event",
+		"meta_event_type": "lifecycle",
+		"sub_type":        subType,
+	})
+}
+
+// PushEvent 推送由通信服务产生的事件
+func (bot *CQBot) PushEvent(m MSG) {
+	bot.dispatchEventMessage(m)
+}
+
+// FlushEvents 等待正在分发的事件处理完成, 超过 timeout 后返回 false
+func (bot *CQBot) FlushEvents(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for atomic.LoadInt32(&bot.pending) > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return true
+}
+
 func (bot *CQBot) dispatchEventMessage(m MSG) {
+	atomic.AddInt32(&bot.pending, 1)
+	defer atomic.AddInt32(&bot.pending, -1)
+	if bot.journal != nil && m["post_type"] != "meta_event" {
+		bot.journal.append(m)
+	}
 	bot.lock.RLock()
 	defer bot.lock.RUnlock()
 
+	metrics.EventsDispatched.Inc(fmt.Sprint(m["post_type"]))
 	event := &Event{RawMsg: m}
 	wg := sync.WaitGroup{}
 	wg.Add(len(bot.events))
-	for _, f := range bot.events {
-		go func(fn func(*Event)) {
+	for _, h := range bot.events {
+		go func(fn func(*Event), name string) {
 			defer func() {
 				wg.Done()
 				if pan := recover(); pan != nil {
@@ -463,15 +494,25 @@ func (bot *CQBot) dispatchEventMessage(m MSG) {
 			start := time.Now()
 			fn(event)
 			end := time.Now()
+			metrics.EventHandlerDuration.Observe(end.Sub(start).Seconds(), name)
 			if end.Sub(start) > time.Second*5 {
 				log.Debugf("警告: 事件处理耗时超过 5 秒 (%v), 请检查应用是否有堵塞.", end.Sub(start))
 			}
-		}(f)
+		}(h.fn, h.name)
 	}
 	wg.Wait()
 	global.PutBuffer(event.buffer)
 }
 
+// handlerName 返回上报函数的名称, 如 server.(*HTTPClient).onBotPushEvent
+func handlerName(f func(*Event)) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	return strings.TrimSuffix(path.Base(fn.Name()), "-fm")
+}
+
 func (bot *CQBot) formatGroupMessage(m *message.GroupMessage) MSG {
 	cqm := ToStringMessage(m.Elements, m.GroupCode, true)
 	gm := MSG{
@@ -359,8 +359,8 @@ func ToStringMessage(e []message.IMessageElement, groupID int64, isRaw ...bool)
 
 // ConvertStringMessage 将消息字符串转为消息元素数组
 func (bot *CQBot) ConvertStringMessage(raw string, isGroup bool) (r []message.IMessageElement) {
-	var t, key string
-	d := map[string]string{}
+	var t string
+	var d map[string]string
 
 	saveCQCode := func() {
 		if t == "reply" { // reply 特殊处理
@@ -466,19 +466,51 @@ func (bot *CQBot) ConvertStringMessage(raw string, isGroup bool) (r []message.IM
 		}
 	}
 
+	parseCQCode(raw, func(text string) {
+		if SplitURL {
+			for _, txt := range global.SplitURL(text) {
+				r = append(r, message.NewText(txt))
+			}
+		} else {
+			r = append(r, message.NewText(text))
+		}
+	}, func(code string, data map[string]string) {
+		t, d = code, data
+		saveCQCode()
+	})
+	return
+}
+
+// ParseCQCodeMessage 将消息字符串解析为消息段数组, 不转换为消息元素
+//
+// 返回的消息段与 ToArrayMessage 的格式相同, 消息段参数均为字符串.
+func ParseCQCodeMessage(raw string) (r []MSG) {
+	r = []MSG{}
+	parseCQCode(raw, func(text string) {
+		r = append(r, MSG{"type": "text", "data": MSG{"text": text}})
+	}, func(t string, d map[string]string) {
+		data := make(MSG, len(d))
+		for k, v := range d {
+			data[k] = v
+		}
+		r = append(r, MSG{"type": t, "data": data})
+	})
+	return
+}
+
+// parseCQCode 解析消息字符串, 依次以解码后的内容调用 text 与 code
+//
+// code 的参数 d 在每次调用后会被复用, 调用方不应持有它.
+func parseCQCode(raw string, text func(string), code func(t string, d map[string]string)) {
+	var t, key string
+	d := map[string]string{}
 	for raw != "" {
 		i := 0
 		for i < len(raw) && !(raw[i] == '[' && i+4 < len(raw) && raw[i:i+4] == "[CQ:") {
 			i++
 		}
 		if i > 0 {
-			if SplitURL {
-				for _, txt := range global.SplitURL(CQCodeUnescapeText(raw[:i])) {
-					r = append(r, message.NewText(txt))
-				}
-			} else {
-				r = append(r, message.NewText(CQCodeUnescapeText(raw[:i])))
-			}
+			text(CQCodeUnescapeText(raw[:i]))
 		}
 
 		if i+4 > len(raw) {
@@ -500,7 +532,7 @@ func (bot *CQBot) ConvertStringMessage(raw string, isGroup bool) (r []message.IM
 		i = 0
 		for {
 			if raw[0] == ']' {
-				saveCQCode()
+				code(t, d)
 				raw = raw[1:]
 				break
 			}
@@ -527,7 +559,6 @@ func (bot *CQBot) ConvertStringMessage(raw string, isGroup bool) (r []message.IM
 			i = 0
 		}
 	}
-	return
 }
 
 // ConvertObjectMessage 将消息JSON对象转为消息元素数组
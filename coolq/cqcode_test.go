@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
 )
 
@@ -15,6 +16,16 @@ func TestCQBot_ConvertStringMessage(t *testing.T) {
 	}
 }
 
+func TestParseCQCodeMessage(t *testing.T) {
+	r := ParseCQCodeMessage(`hi[CQ:at,qq=10001]&#91;[CQ:image,file=a.image,url=http://x/?a=1&amp;b=2]`)
+	assert.Equal(t, []MSG{
+		{"type": "text", "data": MSG{"text": "hi"}},
+		{"type": "at", "data": MSG{"qq": "10001"}},
+		{"type": "text", "data": MSG{"text": "["}},
+		{"type": "image", "data": MSG{"file": "a.image", "url": "http://x/?a=1&b=2"}},
+	}, r)
+}
+
 var bench = `asdfqwerqwerqwer[CQ:face,id=115,text=111]asdfasdfasdfasdfasdfasdfasd[CQ:face,id=217]&#93; 123 &#91;`
 var benchArray = gjson.Parse(`[{"type":"text","data":{"text":"asdfqwerqwerqwer"}},{"type":"face","data":{"id":"115","text":"111"}},{"type":"text","data":{"text":"asdfasdfasdfasdfasdfasdfasd"}},{"type":"face","data":{"id":"217"}},{"type":"text","data":{"text":"] "}},{"type":"text","data":{"text":"123"}},{"type":"text","data":{"text":" ["}}]`)
 
@@ -43,7 +43,8 @@ func (bot *CQBot) privateMessageEvent(c *client.QQClient, m *message.PrivateMess
 	if bot.db != nil {
 		id = bot.InsertPrivateMessage(m)
 	}
-	log.Infof("收到好友 %v(%v) 的消息: %v (%v)", m.Sender.DisplayName(), m.Sender.Uin, cqm, id)
+	bot.logWith(log.Fields{"user_id": m.Sender.Uin, "message_id": id}).
+		Infof("收到好友 %v(%v) 的消息: %v (%v)", m.Sender.DisplayName(), m.Sender.Uin, cqm, id)
 	fm := MSG{
 		"post_type": func() string {
 			if m.Sender.Uin == bot.Client.Uin {
@@ -102,7 +103,8 @@ func (bot *CQBot) groupMessageEvent(c *client.QQClient, m *message.GroupMessage)
 	if bot.db != nil {
 		id = bot.InsertGroupMessage(m)
 	}
-	log.Infof("收到群 %v(%v) 内 %v(%v) 的消息: %v (%v)", m.GroupName, m.GroupCode, m.Sender.DisplayName(), m.Sender.Uin, cqm, id)
+	bot.logWith(log.Fields{"group_id": m.GroupCode, "user_id": m.Sender.Uin, "message_id": id}).
+		Infof("收到群 %v(%v) 内 %v(%v) 的消息: %v (%v)", m.GroupName, m.GroupCode, m.Sender.DisplayName(), m.Sender.Uin, cqm, id)
 	gm := bot.formatGroupMessage(m)
 	if gm == nil {
 		return
@@ -123,7 +125,8 @@ func (bot *CQBot) tempMessageEvent(c *client.QQClient, e *client.TempMessageEven
 	if bot.db != nil {
 		id = bot.InsertTempMessage(m.Sender.Uin, m)
 	}
-	log.Infof("收到来自群 %v(%v) 内 %v(%v) 的临时会话消息: %v", m.GroupName, m.GroupCode, m.Sender.DisplayName(), m.Sender.Uin, cqm)
+	bot.logWith(log.Fields{"group_id": m.GroupCode, "user_id": m.Sender.Uin, "message_id": id}).
+		Infof("收到来自群 %v(%v) 内 %v(%v) 的临时会话消息: %v", m.GroupName, m.GroupCode, m.Sender.DisplayName(), m.Sender.Uin, cqm)
 	tm := MSG{
 		"post_type":    "message",
 		"message_type": "private",
@@ -188,7 +191,7 @@ func (bot *CQBot) groupMutedEvent(c *client.QQClient, e *client.GroupMuteEvent)
 func (bot *CQBot) groupRecallEvent(c *client.QQClient, e *client.GroupMessageRecalledEvent) {
 	g := c.FindGroup(e.GroupCode)
 	gid := toGlobalID(e.GroupCode, e.MessageId)
-	log.Infof("群 %v 内 %v 撤回了 %v 的消息: %v.",
+	bot.logWith(log.Fields{"group_id": e.GroupCode, "user_id": e.OperatorUin, "message_id": gid}).Infof("群 %v 内 %v 撤回了 %v 的消息: %v.",
 		formatGroupName(g), formatMemberName(g.FindMember(e.OperatorUin)), formatMemberName(g.FindMember(e.AuthorUin)), gid)
 	bot.dispatchEventMessage(MSG{
 		"post_type":   "notice",
@@ -286,9 +289,9 @@ func (bot *CQBot) friendRecallEvent(c *client.QQClient, e *client.FriendMessageR
 	f := c.FindFriend(e.FriendUin)
 	gid := toGlobalID(e.FriendUin, e.MessageId)
 	if f != nil {
-		log.Infof("好友 %v(%v) 撤回了消息: %v", f.Nickname, f.Uin, gid)
+		bot.logWith(log.Fields{"user_id": e.FriendUin, "message_id": gid}).Infof("好友 %v(%v) 撤回了消息: %v", f.Nickname, f.Uin, gid)
 	} else {
-		log.Infof("好友 %v 撤回了消息: %v", e.FriendUin, gid)
+		bot.logWith(log.Fields{"user_id": e.FriendUin, "message_id": gid}).Infof("好友 %v 撤回了消息: %v", e.FriendUin, gid)
 	}
 	bot.dispatchEventMessage(MSG{
 		"post_type":   "notice",
@@ -0,0 +1,150 @@
+package coolq
+
+import (
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// EventJournal 事件日志, 以单调递增的序号记录已分发的事件, 用于断线后重放
+//
+// 由支持事件日志的 MessageStore 实现
+type EventJournal interface {
+	// AppendEvent 记录一个事件
+	AppendEvent(seq uint64, t int64, data []byte) error
+	// EventsSince 按序号顺序返回序号大于 seq 且时间不早于 since 的最多 limit 个事件
+	EventsSince(seq uint64, since int64, limit int) ([]JournalEntry, error)
+	// LastEventSeq 返回最后一个事件的序号
+	LastEventSeq() uint64
+	// PruneEvents 删除早于 before 的事件
+	PruneEvents(before int64) error
+}
+
+// JournalEntry 事件日志中的一条记录
+type JournalEntry struct {
+	Seq  uint64
+	Time int64
+	Data []byte
+}
+
+// journal 事件日志状态
+type journal struct {
+	lock      sync.Mutex
+	store     EventJournal
+	seq       uint64
+	retention time.Duration
+	stop      chan struct{}
+}
+
+// openJournal 根据配置启用事件日志, 存储后端不支持时返回 nil
+func openJournal(conf *config.Config, db MessageStore) *journal {
+	node, ok := conf.Database["journal"]
+	if !ok {
+		return nil
+	}
+	jconf := new(config.JournalConfig)
+	_ = node.Decode(jconf)
+	if !jconf.Enable {
+		return nil
+	}
+	store, ok := db.(EventJournal)
+	if !ok {
+		log.Warn("警告: 事件日志需要启用数据库, 将无法使用事件重放功能。")
+		return nil
+	}
+	j := &journal{
+		store:     store,
+		seq:       store.LastEventSeq(),
+		retention: time.Hour * time.Duration(jconf.Retention),
+		stop:      make(chan struct{}),
+	}
+	if j.retention <= 0 {
+		j.retention = time.Hour * 24
+	}
+	go j.prune()
+	log.Infof("事件日志已启用, 当前序号: %v", j.seq)
+	return j
+}
+
+// prune 每小时删除超过保留时间的事件, 直到 close 被调用
+func (j *journal) prune() {
+	t := time.NewTicker(time.Hour)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := j.store.PruneEvents(time.Now().Add(-j.retention).Unix()); err != nil {
+				log.Warnf("清理事件日志时出现错误: %v", err)
+			}
+		case <-j.stop:
+			return
+		}
+	}
+}
+
+// close 停止定期清理
+func (j *journal) close() {
+	close(j.stop)
+}
+
+// append 为事件分配序号并记录
+func (j *journal) append(m MSG) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.seq++
+	m["event_seq"] = j.seq
+	data, err := json.Marshal(m)
+	if err != nil {
+		log.Warnf("记录事件日志时出现错误: %v", err)
+		return
+	}
+	if err = j.store.AppendEvent(j.seq, time.Now().Unix(), data); err != nil {
+		log.Warnf("记录事件日志时出现错误: %v", err)
+	}
+}
+
+// JournalEnabled 是否启用了事件日志
+func (bot *CQBot) JournalEnabled() bool {
+	return bot.journal != nil
+}
+
+// EventsSince 返回序号大于 seq 且时间不早于 since 的事件, 未启用事件日志时返回 false
+func (bot *CQBot) EventsSince(seq uint64, since int64, limit int) ([]jsoniter.RawMessage, bool) {
+	if bot.journal == nil {
+		return nil, false
+	}
+	entries, err := bot.journal.store.EventsSince(seq, since, limit)
+	if err != nil {
+		log.Warnf("读取事件日志时出现错误: %v", err)
+	}
+	ret := make([]jsoniter.RawMessage, 0, len(entries))
+	for _, e := range entries {
+		ret = append(ret, e.Data)
+	}
+	return ret, true
+}
+
+// CQGetEventsSince 扩展API-获取指定序号或时间之后的事件
+func (bot *CQBot) CQGetEventsSince(seq uint64, since int64, limit int) MSG {
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 1000 {
+		limit = 1000
+	}
+	events, ok := bot.EventsSince(seq, since, limit)
+	if !ok {
+		return Failed(100, "JOURNAL_DISABLED", "事件日志未启用")
+	}
+	var last uint64
+	bot.journal.lock.Lock()
+	last = bot.journal.seq
+	bot.journal.lock.Unlock()
+	return OK(MSG{
+		"events":   events,
+		"last_seq": last,
+	})
+}
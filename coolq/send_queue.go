@@ -0,0 +1,386 @@
+package coolq
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
+)
+
+// SendPriority 消息在发送队列中的优先级
+type SendPriority int
+
+// 发送队列的优先级, 数值越小越先发送
+const (
+	PriorityHigh SendPriority = iota
+	PriorityNormal
+	PriorityLow
+)
+
+// ParseSendPriority 解析 API 参数中的 priority, 可为 high, normal 与 low, 默认为 normal
+func ParseSendPriority(s string) SendPriority {
+	switch s {
+	case "high":
+		return PriorityHigh
+	case "low":
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
+
+// sendJob 发送队列中的一条消息
+type sendJob struct {
+	id       string
+	target   string
+	priority SendPriority
+	send     func() int32
+	info     MSG // 上报 send_result 事件时附带的字段
+	retries  int
+	done     chan int32
+	finished bool
+	async    bool // 调用方已停止等待, 发送结果通过事件上报
+}
+
+// sendTarget 单个群或用户的发送状态
+type sendTarget struct {
+	limiter *rate.Limiter
+	busy    bool
+	last    time.Time
+}
+
+// sendQueue 消息发送队列
+//
+// 消息按优先级从高到低调度, 同一群或用户的消息按顺序逐条发送, 不同目标的消息可以并发发送.
+// 群消息与私聊消息发送时检测到风控后将暂停所有发送, 并按指数退避后重试.
+type sendQueue struct {
+	mu          sync.Mutex
+	conf        config.SendQueue
+	global      *rate.Limiter
+	targets     map[string]*sendTarget
+	lanes       [PriorityLow + 1][]*sendJob
+	length      int
+	seq         uint64
+	risk        uint64 // 检测到风控的次数
+	backoff     time.Duration
+	pausedUntil time.Time
+	cleaned     time.Time
+	notify      chan struct{}
+	stop        chan struct{}
+	stopOnce    sync.Once
+	onResult    func(job *sendJob, id int32)
+}
+
+func newSendQueue(conf *config.SendQueue, onResult func(job *sendJob, id int32)) *sendQueue {
+	q := &sendQueue{
+		targets:  make(map[string]*sendTarget),
+		notify:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		onResult: onResult,
+	}
+	q.configure(conf)
+	go q.run()
+	return q
+}
+
+// limit 将每秒的消息数转换为 rate.Limit, 不大于 0 时不限制
+func limit(perSecond float64) rate.Limit {
+	if perSecond <= 0 {
+		return rate.Inf
+	}
+	return rate.Limit(perSecond)
+}
+
+// configure 应用发送队列配置, 已创建的限流器将被重置
+func (q *sendQueue) configure(conf *config.SendQueue) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.conf = *conf
+	if q.conf.Burst <= 0 {
+		q.conf.Burst = 1
+	}
+	if q.conf.Size <= 0 {
+		q.conf.Size = 1000
+	}
+	if q.conf.MaxBackoff <= 0 {
+		q.conf.MaxBackoff = 300
+	}
+	q.global = rate.NewLimiter(limit(q.conf.QPS), q.conf.Burst)
+	for key, t := range q.targets {
+		t.limiter = q.newLimiter(key)
+	}
+	q.wake()
+}
+
+func (q *sendQueue) newLimiter(target string) *rate.Limiter {
+	r := q.conf.UserRate
+	if target[0] == 'g' {
+		r = q.conf.GroupRate
+	}
+	return rate.NewLimiter(limit(r), q.conf.Burst)
+}
+
+func (q *sendQueue) enabled() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.conf.Enabled
+}
+
+func (q *sendQueue) wake() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// push 将消息加入队列, 队列已满时返回 false
+func (q *sendQueue) push(job *sendJob) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.length >= q.conf.Size {
+		return false
+	}
+	q.seq++
+	job.id = strconv.FormatUint(q.seq, 10)
+	job.done = make(chan int32, 1)
+	q.lanes[job.priority] = append(q.lanes[job.priority], job)
+	q.length++
+	q.wake()
+	return true
+}
+
+// wait 等待消息发送完成, 超时后返回 false, 之后的发送结果将通过 onResult 上报
+func (q *sendQueue) wait(job *sendJob, timeout time.Duration) (int32, bool) {
+	if timeout <= 0 {
+		return <-job.done, true
+	}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case id := <-job.done:
+		return id, true
+	case <-t.C:
+	}
+	q.mu.Lock()
+	if job.finished {
+		q.mu.Unlock()
+		return <-job.done, true
+	}
+	job.async = true
+	q.mu.Unlock()
+	return 0, false
+}
+
+// riskControlled 记录一次风控, 暂停发送的时间从 1 秒开始倍增, 最长为 max-backoff 秒
+func (q *sendQueue) riskControlled() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.risk++
+	max := time.Second * time.Duration(q.conf.MaxBackoff)
+	q.backoff *= 2
+	if q.backoff < time.Second {
+		q.backoff = time.Second
+	}
+	if q.backoff > max {
+		q.backoff = max
+	}
+	q.pausedUntil = time.Now().Add(q.backoff)
+	metrics.SendRiskControlled.Inc()
+	if q.conf.Enabled {
+		log.Warnf("检测到风控, 发送队列将暂停 %v.", q.backoff)
+	}
+}
+
+// close 停止调度发送队列, 队列中尚未发送的消息将被丢弃
+func (q *sendQueue) close() {
+	q.stopOnce.Do(func() { close(q.stop) })
+}
+
+func (q *sendQueue) run() {
+	for {
+		job := q.next()
+		if job == nil {
+			return
+		}
+		go q.exec(job)
+	}
+}
+
+// next 阻塞直到有可以发送的消息, 发送队列停止时返回 nil
+func (q *sendQueue) next() *sendJob {
+	for {
+		q.mu.Lock()
+		job, wait := q.pick(time.Now())
+		q.mu.Unlock()
+		if job != nil {
+			return job
+		}
+		if wait < 0 {
+			select {
+			case <-q.notify:
+			case <-q.stop:
+				return nil
+			}
+			continue
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-q.notify:
+		case <-t.C:
+		case <-q.stop:
+			t.Stop()
+			return nil
+		}
+		t.Stop()
+	}
+}
+
+// pick 按优先级选出第一条可以发送的消息, 没有时返回需要等待的时间, 小于 0 表示等待新消息
+func (q *sendQueue) pick(now time.Time) (*sendJob, time.Duration) {
+	if now.Before(q.pausedUntil) {
+		return nil, q.pausedUntil.Sub(now)
+	}
+	if now.Sub(q.cleaned) > time.Minute {
+		q.cleaned = now
+		for key, t := range q.targets {
+			if !t.busy && now.Sub(t.last) > time.Minute {
+				delete(q.targets, key)
+			}
+		}
+	}
+	wait := time.Duration(-1)
+	blocked := make(map[string]bool)
+	for p := range q.lanes {
+		for i, job := range q.lanes[p] {
+			if blocked[job.target] {
+				continue
+			}
+			t := q.targets[job.target]
+			if t == nil {
+				t = &sendTarget{limiter: q.newLimiter(job.target)}
+				q.targets[job.target] = t
+			}
+			if t.busy {
+				blocked[job.target] = true
+				continue
+			}
+			r := t.limiter.ReserveN(now, 1)
+			if d := r.DelayFrom(now); d > 0 {
+				r.CancelAt(now)
+				blocked[job.target] = true
+				if wait < 0 || d < wait {
+					wait = d
+				}
+				continue
+			}
+			g := q.global.ReserveN(now, 1)
+			if d := g.DelayFrom(now); d > 0 {
+				g.CancelAt(now)
+				r.CancelAt(now)
+				return nil, d
+			}
+			t.busy = true
+			t.last = now
+			q.lanes[p] = append(q.lanes[p][:i], q.lanes[p][i+1:]...)
+			q.length--
+			return job, 0
+		}
+	}
+	return nil, wait
+}
+
+// exec 发送消息, 因风控失败的消息将重新放回队首
+func (q *sendQueue) exec(job *sendJob) {
+	q.mu.Lock()
+	risk := q.risk
+	q.mu.Unlock()
+	id := job.send()
+	q.mu.Lock()
+	q.targets[job.target].busy = false
+	if id == -1 && q.risk != risk && job.retries < q.conf.MaxRetries {
+		job.retries++
+		q.lanes[job.priority] = append([]*sendJob{job}, q.lanes[job.priority]...)
+		q.length++
+		q.mu.Unlock()
+		q.wake()
+		metrics.SendQueueMessages.Inc("retried")
+		log.Infof("消息 %v 因风控发送失败, 将在暂停结束后第 %v 次重试.", job.id, job.retries)
+		return
+	}
+	if id != -1 {
+		q.backoff = 0
+	}
+	job.finished = true
+	async := job.async
+	q.mu.Unlock()
+	q.wake()
+	if id == -1 {
+		metrics.SendQueueMessages.Inc("failed")
+	} else {
+		metrics.SendQueueMessages.Inc("sent")
+	}
+	job.done <- id
+	if async {
+		q.onResult(job, id)
+	}
+}
+
+// sendQueued 通过发送队列发送消息, 未启用发送队列时直接调用 send
+//
+// 队列已满或等待超过 wait-timeout 时返回的 MSG 不为 nil, 应作为 API 的返回值.
+// 等待超时的消息发送完成后将上报 send_result 事件.
+func (bot *CQBot) sendQueued(messageType string, targetID int64, priority SendPriority, send func() int32) (int32, MSG) {
+	if !bot.sendQueue.enabled() {
+		return send(), nil
+	}
+	idKey := "user_id"
+	if messageType == "group" {
+		idKey = "group_id"
+	}
+	job := &sendJob{
+		target:   messageType + ":" + strconv.FormatInt(targetID, 10),
+		priority: priority,
+		send:     send,
+		info:     MSG{"message_type": messageType, idKey: targetID},
+	}
+	if !bot.sendQueue.push(job) {
+		log.Warnf("消息发送失败: 发送队列已满.")
+		return -1, Failed(100, "SEND_QUEUE_FULL", "发送队列已满")
+	}
+	bot.sendQueue.mu.Lock()
+	timeout := time.Millisecond * time.Duration(bot.sendQueue.conf.WaitTimeout)
+	bot.sendQueue.mu.Unlock()
+	if id, ok := bot.sendQueue.wait(job, timeout); ok {
+		return id, nil
+	}
+	return 0, MSG{"data": MSG{"queue_id": job.id}, "retcode": 1, "status": "async"}
+}
+
+// sendResultEvent 上报等待超时的消息的发送结果
+func (bot *CQBot) sendResultEvent(job *sendJob, id int32) {
+	m := MSG{
+		"time":        time.Now().Unix(),
+		"self_id":     bot.Client.Uin,
+		"post_type":   "notice",
+		"notice_type": "send_result",
+		"queue_id":    job.id,
+		"success":     id != -1,
+	}
+	if id != -1 {
+		m["message_id"] = id
+	}
+	for k, v := range job.info {
+		m[k] = v
+	}
+	bot.dispatchEventMessage(m)
+}
+
+// SetSendQueueConfig 应用发送队列配置
+func (bot *CQBot) SetSendQueueConfig(conf *config.SendQueue) {
+	bot.sendQueue.configure(conf)
+}
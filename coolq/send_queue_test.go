@@ -0,0 +1,78 @@
+package coolq
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestSendQueuePriority(t *testing.T) {
+	q := newSendQueue(&config.SendQueue{Enabled: true}, func(*sendJob, int32) {})
+	q.mu.Lock()
+	q.pausedUntil = time.Now().Add(time.Millisecond * 50)
+	q.mu.Unlock()
+	var (
+		lock  sync.Mutex
+		order []SendPriority
+		jobs  []*sendJob
+	)
+	for _, p := range []SendPriority{PriorityLow, PriorityNormal, PriorityHigh} {
+		p := p
+		job := &sendJob{target: "group:1", priority: p, send: func() int32 {
+			lock.Lock()
+			order = append(order, p)
+			lock.Unlock()
+			return 1
+		}}
+		assert.True(t, q.push(job))
+		jobs = append(jobs, job)
+	}
+	for _, job := range jobs {
+		id, ok := q.wait(job, 0)
+		assert.True(t, ok)
+		assert.Equal(t, int32(1), id)
+	}
+	assert.Equal(t, []SendPriority{PriorityHigh, PriorityNormal, PriorityLow}, order)
+}
+
+func TestSendQueueRiskControl(t *testing.T) {
+	results := make(chan int32, 1)
+	q := newSendQueue(&config.SendQueue{Enabled: true, MaxRetries: 1, Size: 1}, func(_ *sendJob, id int32) {
+		results <- id
+	})
+	calls := 0
+	job := &sendJob{target: "private:1", priority: PriorityNormal, send: func() int32 {
+		calls++
+		if calls == 1 {
+			q.riskControlled()
+			return -1
+		}
+		return 42
+	}}
+	assert.True(t, q.push(job))
+	assert.False(t, q.push(&sendJob{target: "private:2"}))
+	_, ok := q.wait(job, time.Millisecond*100)
+	assert.False(t, ok)
+	assert.Equal(t, int32(42), <-results)
+	assert.Equal(t, 2, calls)
+}
+
+func TestSendQueueClose(t *testing.T) {
+	q := newSendQueue(&config.SendQueue{Enabled: true}, func(*sendJob, int32) {})
+	done := make(chan struct{})
+	go func() {
+		assert.Nil(t, q.next())
+		close(done)
+	}()
+	q.close()
+	q.close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("send queue is still running after close")
+	}
+}
@@ -0,0 +1,89 @@
+package coolq
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/Mrs4s/MiraiGo/message"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// ErrMessageNotFound 消息不存在时返回的错误
+var ErrMessageNotFound = errors.New("message not found")
+
+// MessageStore 消息存储后端, 用于实现 回复/撤回/get_msg 等上下文相关功能
+type MessageStore interface {
+	// Insert 以 id 为键保存消息
+	Insert(id int32, m MSG) error
+	// Get 获取 id 对应的消息, 不存在时返回 ErrMessageNotFound
+	Get(id int32) (MSG, error)
+	// Close 关闭存储后端
+	Close() error
+}
+
+func init() {
+	gob.Register(message.Sender{})
+}
+
+// NewMessageStore 根据配置文件中的 database 节选择并打开消息存储后端
+//
+// 同时启用多个后端时按 leveldb, sqlite3, memory 的顺序选择第一个, 全部关闭时返回 nil
+func NewMessageStore(conf *config.Config) (MessageStore, error) {
+	dir := conf.Account.DataPath()
+	if node, ok := conf.Database["leveldb"]; ok {
+		lconf := new(config.LevelDBConfig)
+		_ = node.Decode(lconf)
+		if lconf.Enable {
+			return openLevelDBStore(lconf, dir)
+		}
+	}
+	if node, ok := conf.Database["sqlite3"]; ok {
+		sconf := new(config.SQLiteConfig)
+		_ = node.Decode(sconf)
+		if sconf.Enable {
+			return openSQLiteStore(sconf, dir)
+		}
+	}
+	if node, ok := conf.Database["memory"]; ok {
+		mconf := new(config.MemoryDBConfig)
+		_ = node.Decode(mconf)
+		if mconf.Enable {
+			return newMemoryStore(mconf), nil
+		}
+	}
+	return nil, nil
+}
+
+// encodeMessage 使用 gob 编码消息
+func encodeMessage(m MSG) ([]byte, error) {
+	buf := global.NewBuffer()
+	defer global.PutBuffer(buf)
+	if err := gob.NewEncoder(buf).Encode(m); err != nil {
+		return nil, errors.Wrap(err, "encode message error")
+	}
+	return append([]byte(nil), buf.Bytes()...), nil
+}
+
+// decodeMessage 使用 gob 解码消息
+func decodeMessage(data []byte) (MSG, error) {
+	m := MSG{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&m); err != nil {
+		return nil, errors.Wrap(err, "decode message error")
+	}
+	return m, nil
+}
+
+// insertMessage 将消息写入存储后端, 失败时返回 -1
+func (bot *CQBot) insertMessage(id int32, val MSG) int32 {
+	if bot.db != nil {
+		if err := bot.db.Insert(id, val); err != nil {
+			log.Warnf("记录聊天数据时出现错误: %v", err)
+			return -1
+		}
+	}
+	return id
+}
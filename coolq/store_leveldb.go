@@ -0,0 +1,179 @@
+package coolq
+
+import (
+	stdbinary "encoding/binary"
+	"math"
+	"path"
+	"sync"
+
+	"github.com/Mrs4s/MiraiGo/binary"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
+)
+
+// levelDBStore 基于 leveldb 的消息存储
+type levelDBStore struct {
+	db *leveldb.DB
+}
+
+func openLevelDBStore(_ *config.LevelDBConfig, dir string) (MessageStore, error) {
+	p := path.Join(dir, "leveldb")
+	db, err := leveldb.OpenFile(p, &opt.Options{
+		WriteBuffer: 128 * opt.KiB,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "open leveldb %v error", p)
+	}
+	s := &levelDBStore{db: db}
+	levelDBStoresMu.Lock()
+	levelDBStores = append(levelDBStores, s)
+	levelDBStoresMu.Unlock()
+	levelDBGaugeOnce.Do(func() {
+		metrics.NewGaugeFunc("cqhttp_leveldb_size_bytes", "leveldb 数据库大小", totalLevelDBSize)
+	})
+	return s, nil
+}
+
+var (
+	levelDBStores    []*levelDBStore
+	levelDBStoresMu  sync.Mutex
+	levelDBGaugeOnce sync.Once
+)
+
+// totalLevelDBSize 返回所有已打开的 leveldb 数据库的总大小
+func totalLevelDBSize() float64 {
+	levelDBStoresMu.Lock()
+	defer levelDBStoresMu.Unlock()
+	var total float64
+	for _, s := range levelDBStores {
+		total += s.size()
+	}
+	return total
+}
+
+// size 返回数据库各层文件的总大小
+func (s *levelDBStore) size() float64 {
+	var stats leveldb.DBStats
+	if err := s.db.Stats(&stats); err != nil {
+		return 0
+	}
+	var total int64
+	for _, n := range stats.LevelSizes {
+		total += n
+	}
+	return float64(total)
+}
+
+func (s *levelDBStore) Insert(id int32, m MSG) error {
+	data, err := encodeMessage(m)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(s.db.Put(binary.ToBytes(id), data, nil), "put leveldb error")
+}
+
+func (s *levelDBStore) Get(id int32) (MSG, error) {
+	data, err := s.db.Get(binary.ToBytes(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "get leveldb error")
+	}
+	return decodeMessage(data)
+}
+
+// eventKeyPrefix 事件日志的键前缀
+//
+// 前缀长于4字节的消息ID, 因此任何消息的键都不会以该前缀开头.
+var eventKeyPrefix = []byte("\x00evt:")
+
+func eventKey(seq uint64) []byte {
+	k := make([]byte, len(eventKeyPrefix)+8)
+	copy(k, eventKeyPrefix)
+	stdbinary.BigEndian.PutUint64(k[len(eventKeyPrefix):], seq)
+	return k
+}
+
+// eventSeq 返回事件日志键中的序号, 键的长度不正确时返回 false
+func eventSeq(key []byte) (uint64, bool) {
+	if len(key) != len(eventKeyPrefix)+8 {
+		return 0, false
+	}
+	return stdbinary.BigEndian.Uint64(key[len(eventKeyPrefix):]), true
+}
+
+func (s *levelDBStore) AppendEvent(seq uint64, t int64, data []byte) error {
+	v := make([]byte, 8+len(data))
+	stdbinary.BigEndian.PutUint64(v, uint64(t))
+	copy(v[8:], data)
+	return errors.Wrap(s.db.Put(eventKey(seq), v, nil), "put leveldb error")
+}
+
+func (s *levelDBStore) EventsSince(seq uint64, since int64, limit int) ([]JournalEntry, error) {
+	iter := s.db.NewIterator(&util.Range{Start: eventKey(seq + 1), Limit: eventKey(math.MaxUint64)}, nil)
+	defer iter.Release()
+	var ret []JournalEntry
+	for iter.Next() && len(ret) < limit {
+		v := iter.Value()
+		seq, ok := eventSeq(iter.Key())
+		if !ok || len(v) < 8 {
+			continue
+		}
+		t := int64(stdbinary.BigEndian.Uint64(v))
+		if t < since {
+			continue
+		}
+		ret = append(ret, JournalEntry{
+			Seq:  seq,
+			Time: t,
+			Data: append([]byte(nil), v[8:]...),
+		})
+	}
+	return ret, errors.Wrap(iter.Error(), "iterate leveldb error")
+}
+
+func (s *levelDBStore) LastEventSeq() uint64 {
+	iter := s.db.NewIterator(util.BytesPrefix(eventKeyPrefix), nil)
+	defer iter.Release()
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		if seq, ok := eventSeq(iter.Key()); ok {
+			return seq
+		}
+	}
+	return 0
+}
+
+func (s *levelDBStore) PruneEvents(before int64) error {
+	iter := s.db.NewIterator(util.BytesPrefix(eventKeyPrefix), nil)
+	defer iter.Release()
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		if _, ok := eventSeq(iter.Key()); !ok {
+			continue
+		}
+		v := iter.Value()
+		if len(v) >= 8 && int64(stdbinary.BigEndian.Uint64(v)) >= before {
+			break
+		}
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+	return errors.Wrap(s.db.Write(batch, nil), "prune leveldb error")
+}
+
+func (s *levelDBStore) Close() error {
+	levelDBStoresMu.Lock()
+	for i, store := range levelDBStores {
+		if store == s {
+			levelDBStores = append(levelDBStores[:i], levelDBStores[i+1:]...)
+			break
+		}
+	}
+	levelDBStoresMu.Unlock()
+	return s.db.Close()
+}
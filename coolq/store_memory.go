@@ -0,0 +1,117 @@
+package coolq
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// defaultMemoryStoreSize 内存数据库默认保存的消息数量
+const defaultMemoryStoreSize = 10000
+
+// memoryStore 基于 LRU 的内存消息存储, 适用于无持久化存储的实例
+type memoryStore struct {
+	lock  sync.Mutex
+	size  int
+	ll    *list.List
+	items map[int32]*list.Element
+
+	events []JournalEntry // 按序号排列的事件日志, 最多保存 size 条
+}
+
+type memoryEntry struct {
+	id  int32
+	msg MSG
+}
+
+func newMemoryStore(conf *config.MemoryDBConfig) MessageStore {
+	size := conf.Size
+	if size <= 0 {
+		size = defaultMemoryStoreSize
+	}
+	return &memoryStore{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[int32]*list.Element, size),
+	}
+}
+
+func (s *memoryStore) Insert(id int32, m MSG) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if e, ok := s.items[id]; ok {
+		e.Value.(*memoryEntry).msg = m
+		s.ll.MoveToFront(e)
+		return nil
+	}
+	s.items[id] = s.ll.PushFront(&memoryEntry{id: id, msg: m})
+	for s.ll.Len() > s.size {
+		last := s.ll.Back()
+		s.ll.Remove(last)
+		delete(s.items, last.Value.(*memoryEntry).id)
+	}
+	return nil
+}
+
+func (s *memoryStore) Get(id int32) (MSG, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	e, ok := s.items[id]
+	if !ok {
+		return nil, ErrMessageNotFound
+	}
+	s.ll.MoveToFront(e)
+	return e.Value.(*memoryEntry).msg, nil
+}
+
+func (s *memoryStore) AppendEvent(seq uint64, t int64, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.events) >= s.size {
+		n := copy(s.events, s.events[len(s.events)-s.size+1:])
+		s.events = s.events[:n]
+	}
+	s.events = append(s.events, JournalEntry{Seq: seq, Time: t, Data: append([]byte(nil), data...)})
+	return nil
+}
+
+func (s *memoryStore) EventsSince(seq uint64, since int64, limit int) ([]JournalEntry, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	i := sort.Search(len(s.events), func(i int) bool { return s.events[i].Seq > seq })
+	var ret []JournalEntry
+	for ; i < len(s.events) && len(ret) < limit; i++ {
+		if s.events[i].Time >= since {
+			ret = append(ret, s.events[i])
+		}
+	}
+	return ret, nil
+}
+
+func (s *memoryStore) LastEventSeq() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.events) == 0 {
+		return 0
+	}
+	return s.events[len(s.events)-1].Seq
+}
+
+func (s *memoryStore) PruneEvents(before int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	i := sort.Search(len(s.events), func(i int) bool { return s.events[i].Time >= before })
+	s.events = append(s.events[:0], s.events[i:]...)
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ll.Init()
+	s.items = make(map[int32]*list.Element)
+	s.events = nil
+	return nil
+}
@@ -0,0 +1,105 @@
+package coolq
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	_ "modernc.org/sqlite" // sqlite3 driver
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// sqliteStore 基于 SQLite 的消息存储
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func openSQLiteStore(conf *config.SQLiteConfig, dir string) (MessageStore, error) {
+	file := conf.File
+	if file == "" {
+		file = path.Join(dir, "sqlite3", "msg.db")
+	}
+	if err := os.MkdirAll(path.Dir(file), 0o755); err != nil {
+		return nil, errors.Wrap(err, "create sqlite3 dir error")
+	}
+	db, err := sql.Open("sqlite", file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open sqlite3 %v error", file)
+	}
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE IF NOT EXISTS messages (id INTEGER PRIMARY KEY, data BLOB NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS events (seq INTEGER PRIMARY KEY, time INTEGER NOT NULL, data BLOB NOT NULL)`,
+		`CREATE INDEX IF NOT EXISTS events_time ON events (time)`,
+	} {
+		if _, err = db.Exec(stmt); err != nil {
+			_ = db.Close()
+			return nil, errors.Wrap(err, "create sqlite3 table error")
+		}
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) Insert(id int32, m MSG) error {
+	data, err := encodeMessage(m)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`INSERT OR REPLACE INTO messages (id, data) VALUES (?, ?)`, id, data)
+	return errors.Wrap(err, "insert sqlite3 error")
+}
+
+func (s *sqliteStore) Get(id int32) (MSG, error) {
+	var data []byte
+	err := s.db.QueryRow(`SELECT data FROM messages WHERE id = ?`, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query sqlite3 error")
+	}
+	return decodeMessage(data)
+}
+
+func (s *sqliteStore) AppendEvent(seq uint64, t int64, data []byte) error {
+	_, err := s.db.Exec(`INSERT OR REPLACE INTO events (seq, time, data) VALUES (?, ?, ?)`, int64(seq), t, data)
+	return errors.Wrap(err, "insert sqlite3 error")
+}
+
+func (s *sqliteStore) EventsSince(seq uint64, since int64, limit int) ([]JournalEntry, error) {
+	rows, err := s.db.Query(`SELECT seq, time, data FROM events WHERE seq > ? AND time >= ? ORDER BY seq LIMIT ?`, int64(seq), since, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "query sqlite3 error")
+	}
+	defer rows.Close()
+	var ret []JournalEntry
+	for rows.Next() {
+		var (
+			e   JournalEntry
+			seq int64
+		)
+		if err = rows.Scan(&seq, &e.Time, &e.Data); err != nil {
+			return ret, errors.Wrap(err, "scan sqlite3 error")
+		}
+		e.Seq = uint64(seq)
+		ret = append(ret, e)
+	}
+	return ret, errors.Wrap(rows.Err(), "query sqlite3 error")
+}
+
+func (s *sqliteStore) LastEventSeq() uint64 {
+	var seq sql.NullInt64
+	_ = s.db.QueryRow(`SELECT MAX(seq) FROM events`).Scan(&seq)
+	return uint64(seq.Int64)
+}
+
+func (s *sqliteStore) PruneEvents(before int64) error {
+	_, err := s.db.Exec(`DELETE FROM events WHERE time < ?`, before)
+	return errors.Wrap(err, "prune sqlite3 error")
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,112 @@
+package coolq
+
+import (
+	stdbinary "encoding/binary"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := newMemoryStore(&config.MemoryDBConfig{Enable: true, Size: 2})
+	assert.NoError(t, s.Insert(1, MSG{"message": "a"}))
+	assert.NoError(t, s.Insert(2, MSG{"message": "b"}))
+	_, _ = s.Get(1) // 1 成为最近使用
+	assert.NoError(t, s.Insert(3, MSG{"message": "c"}))
+
+	_, err := s.Get(2)
+	assert.Equal(t, ErrMessageNotFound, err)
+	m, err := s.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", m["message"])
+}
+
+func TestSQLiteStore(t *testing.T) {
+	s, err := openSQLiteStore(&config.SQLiteConfig{Enable: true, File: path.Join(t.TempDir(), "msg.db")}, "")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	assert.NoError(t, s.Insert(-42, MSG{"message": "hello", "time": int32(1)}))
+	m, err := s.Get(-42)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", m["message"])
+	assert.Equal(t, int32(1), m["time"])
+
+	_, err = s.Get(1)
+	assert.Equal(t, ErrMessageNotFound, err)
+}
+
+func TestEventJournal(t *testing.T) {
+	sqlite, err := openSQLiteStore(&config.SQLiteConfig{Enable: true, File: path.Join(t.TempDir(), "msg.db")}, "")
+	assert.NoError(t, err)
+	defer sqlite.Close()
+	level, err := openLevelDBStore(&config.LevelDBConfig{Enable: true}, t.TempDir())
+	assert.NoError(t, err)
+	defer level.Close()
+	stores := map[string]MessageStore{
+		"memory":  newMemoryStore(&config.MemoryDBConfig{Enable: true, Size: 3}),
+		"sqlite":  sqlite,
+		"leveldb": level,
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			j := s.(EventJournal)
+			assert.Equal(t, uint64(0), j.LastEventSeq())
+			for i := uint64(1); i <= 3; i++ {
+				assert.NoError(t, j.AppendEvent(i, int64(i*100), []byte{byte(i)}))
+			}
+			assert.Equal(t, uint64(3), j.LastEventSeq())
+
+			events, err := j.EventsSince(1, 0, 10)
+			assert.NoError(t, err)
+			assert.Len(t, events, 2)
+			assert.Equal(t, uint64(2), events[0].Seq)
+
+			events, _ = j.EventsSince(0, 300, 10)
+			assert.Len(t, events, 1)
+			assert.Equal(t, []byte{3}, events[0].Data)
+
+			assert.NoError(t, j.PruneEvents(200))
+			events, _ = j.EventsSince(0, 0, 10)
+			assert.Len(t, events, 2)
+		})
+	}
+}
+
+func TestLevelDBEventKey(t *testing.T) {
+	s, err := openLevelDBStore(&config.LevelDBConfig{Enable: true}, t.TempDir())
+	assert.NoError(t, err)
+	defer s.Close()
+	db := s.(*levelDBStore).db
+
+	// 与事件日志前缀相同或以其开头的键不应被当作事件
+	for _, key := range [][]byte{eventKeyPrefix, append(append([]byte(nil), eventKeyPrefix...), 1)} {
+		assert.NoError(t, db.Put(key, []byte("message"), nil))
+	}
+	ids := []int32{int32(stdbinary.BigEndian.Uint32(eventKeyPrefix)), int32(stdbinary.BigEndian.Uint32([]byte("evt:")))}
+	for _, id := range ids {
+		assert.NoError(t, s.Insert(id, MSG{"message": "hello"}))
+	}
+
+	j := s.(EventJournal)
+	assert.Equal(t, uint64(0), j.LastEventSeq())
+	assert.NoError(t, j.AppendEvent(1, 100, []byte{1}))
+	assert.Equal(t, uint64(1), j.LastEventSeq())
+	events, err := j.EventsSince(0, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	assert.NoError(t, j.PruneEvents(200))
+	for _, id := range ids {
+		m, err := s.Get(id)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", m["message"])
+	}
+	for _, key := range [][]byte{eventKeyPrefix, append(append([]byte(nil), eventKeyPrefix...), 1)} {
+		ok, _ := db.Has(key, nil)
+		assert.True(t, ok)
+	}
+}
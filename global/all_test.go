@@ -1,10 +1,19 @@
 package global
 
 import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 )
 
 func TestVersionNameCompare(t *testing.T) {
@@ -34,3 +43,101 @@ func TestVersionNameCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestNewLogFormatter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(NewLogFormatter("json"))
+	logger.WithFields(logrus.Fields{"request_id": "a", "action": "get_status"}).Info("test")
+	assert.Contains(t, buf.String(), `"request_id":"a"`)
+	assert.Contains(t, buf.String(), `"action":"get_status"`)
+
+	buf.Reset()
+	logger.SetFormatter(NewLogFormatter(""))
+	logger.WithField("request_id", "a").Info("test")
+	assert.Contains(t, buf.String(), "[INFO]: test")
+	assert.NotContains(t, buf.String(), "request_id")
+}
+
+func TestLogSinkWriters(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer pc.Close()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	nw := NewNetWriter("udp", pc.LocalAddr().String())
+	logger.AddHook(NewLocalHook(nw, NewSyslogFormatter(NewLogFormatter("text"), "test"), logrus.WarnLevel))
+	logger.Warn("hello")
+	buf := make([]byte, 1024)
+	n, _, err := pc.ReadFrom(buf)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(buf[:n]), "<12>"))
+	assert.Contains(t, string(buf[:n]), "test[")
+	assert.Contains(t, string(buf[:n]), "hello")
+	_ = nw.Close()
+
+	// 无法连接的 TCP 目标不应阻塞写入
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := lis.Addr().String()
+	_ = lis.Close()
+	nw = NewNetWriter("tcp", addr)
+	start := time.Now()
+	for i := 0; i < 10000; i++ {
+		_, err = nw.Write([]byte("hello\n"))
+		assert.NoError(t, err)
+	}
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	_ = nw.Close()
+
+	received := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- string(b)
+	}))
+	defer srv.Close()
+	hw := NewHTTPBatchWriter(srv.URL, "text/plain", 2, time.Hour)
+	_, _ = hw.Write([]byte("a\n"))
+	_, _ = hw.Write([]byte("b\n"))
+	assert.Equal(t, "a\nb\n", <-received)
+	_, _ = hw.Write([]byte("c\n"))
+	_ = hw.Close()
+	assert.Equal(t, "c\n", <-received)
+}
+
+func TestFilterOperators(t *testing.T) {
+	// 2021-06-07 10:30 (星期一, 本地时区)
+	ts := time.Date(2021, 6, 7, 10, 30, 0, 0, time.Local).Unix()
+	event := gjson.Parse(`{"time":` + strconv.FormatInt(ts, 10) + `,"group_id":123,"raw_message":"!!hello",` +
+		`"sender":{"level":"12"},"message":[{"type":"text","data":{"text":"hi"}},{"type":"at","data":{"qq":"10001"}}]}`)
+	tests := []struct {
+		rule   string
+		passed bool
+		node   string
+	}{
+		{`{"group_id":{".gt":100,".lte":123}}`, true, ""},
+		{`{"group_id":{".gt":123}}`, false, "group_id/.gt"},
+		{`{"sender.level":{".gte":10}}`, true, ""},
+		{`{"anonymous":{".exists":false},"user_id":{".exists":true}}`, false, "user_id/.exists"},
+		{`{"raw_message":{".startswith":"!!",".endswith":"lo"}}`, true, ""},
+		{`{".time_of_day":"09:00-18:00",".weekday":[1,2,3,4,5]}`, true, ""},
+		{`{".time_of_day":"22:00-06:00"}`, false, ".time_of_day"},
+		{`{".weekday":7}`, false, ".weekday"},
+		{`{"message":{".segment":"at"}}`, true, ""},
+		{`{"message":{".segment":{"type":"at","data.qq":"10002"}}}`, false, "message/.segment"},
+		{`{".or":[{"group_id":1},{"group_id":2}]}`, false, ".or"},
+		{`{".not":{"group_id":123}}`, false, ".not"},
+	}
+	for _, tt := range tests {
+		passed, node := Explain(Generate("and", gjson.Parse(tt.rule)), event)
+		assert.Equal(t, tt.passed, passed, tt.rule)
+		assert.Equal(t, tt.node, node, tt.rule)
+	}
+
+	seg := Generate("segment", gjson.Parse(`{"type":"face","data.id":"123"}`))
+	assert.True(t, seg.Eval(gjson.Parse(`"hi[CQ:face,id=123]"`)))
+	assert.False(t, seg.Eval(gjson.Parse(`"hi&#91;CQ:face,id=123&#93;"`)))
+	assert.True(t, Generate("segment", gjson.Parse(`{"data.text":"a,[b]"}`)).Eval(gjson.Parse(`"a&#44;&#91;b&#93;"`)))
+	assert.Panics(t, func() { Generate("time_of_day", gjson.Parse(`"9点"`)) })
+}
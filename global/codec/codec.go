@@ -14,8 +14,6 @@ import (
 	"github.com/wdvxdr1123/go-silk"
 )
 
-const silkCachePath = "/mnt/data/cache"
-
 // EncodeToSilk 将音频编码为Silk
 func EncodeToSilk(record []byte, tempName string, useCache bool) (silkWav []byte, err error) {
 	// 1. 写入缓存文件
@@ -0,0 +1,9 @@
+package codec
+
+// silkCachePath silk 编码时使用的临时文件目录
+var silkCachePath = "/mnt/data/cache"
+
+// SetCachePath 设置 silk 编码时使用的临时文件目录
+func SetCachePath(p string) {
+	silkCachePath = p
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/Mrs4s/go-cqhttp/global"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
@@ -26,27 +27,111 @@ var currentPath = getCurrentPath()
 // DefaultConfigFile 默认配置文件路径
 var DefaultConfigFile = path.Join(currentPath, "config.yml")
 
+// Account 账号相关配置
+type Account struct {
+	Uin      int64  `yaml:"uin"`
+	Password string `yaml:"password"`
+	Encrypt  bool   `yaml:"encrypt"`
+	Status   int32  `yaml:"status"`
+	ReLogin  struct {
+		Disabled bool `yaml:"disabled"`
+		Delay    uint `yaml:"delay"`
+		MaxTimes uint `yaml:"max-times"`
+		Interval int  `yaml:"interval"`
+	}
+	UseSSOAddress bool `yaml:"use-sso-address"`
+	LoginBroker   struct {
+		Enabled bool `yaml:"enabled"`
+		Timeout int  `yaml:"timeout"`
+	} `yaml:"login-broker"`
+	DataDir string `yaml:"data-dir"`
+}
+
+// DataPath 返回账号的数据目录, 未设置 data-dir 时为 data
+func (a *Account) DataPath() string {
+	if a.DataDir == "" {
+		return global.DataPath
+	}
+	return a.DataDir
+}
+
+// AsyncConfig _async 后缀 API 的执行配置
+type AsyncConfig struct {
+	Workers     int  `yaml:"workers"`
+	QueueSize   int  `yaml:"queue-size"`
+	ResultTTL   int  `yaml:"result-ttl"`
+	ReportEvent bool `yaml:"report-event"`
+}
+
+// LogSink 日志输出配置
+type LogSink struct {
+	Type   string `yaml:"type"`
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+
+	Dir string `yaml:"dir"`
+
+	Network string `yaml:"network"`
+	Address string `yaml:"address"`
+	Tag     string `yaml:"tag"`
+
+	URL           string `yaml:"url"`
+	BatchSize     int    `yaml:"batch-size"`
+	FlushInterval int    `yaml:"flush-interval"`
+}
+
+// SendQueue 消息发送队列配置
+type SendQueue struct {
+	Enabled     bool    `yaml:"enabled"`
+	QPS         float64 `yaml:"qps"`
+	GroupRate   float64 `yaml:"group-rate"`
+	UserRate    float64 `yaml:"user-rate"`
+	Burst       int     `yaml:"burst"`
+	Size        int     `yaml:"size"`
+	WaitTimeout int     `yaml:"wait-timeout"`
+	MaxRetries  int     `yaml:"max-retries"`
+	MaxBackoff  int     `yaml:"max-backoff"`
+}
+
+// AccountEntry 多账号模式下单个账号的配置
+//
+// 未设置 database 时使用全局的数据库配置, servers 中的通信服务仅服务于该账号.
+type AccountEntry struct {
+	Account  `yaml:",inline"`
+	Database map[string]yaml.Node   `yaml:"database"`
+	Servers  []map[string]yaml.Node `yaml:"servers"`
+}
+
+// ForAccount 返回 accounts 中的账号 e 使用的配置
+//
+// 除账号, 数据库与通信服务外的配置与全局配置相同.
+func (c *Config) ForAccount(e *AccountEntry) *Config {
+	ac := *c
+	ac.Account = e.Account
+	ac.Accounts = nil
+	ac.Servers = e.Servers
+	if e.Database != nil {
+		ac.Database = e.Database
+	}
+	return &ac
+}
+
 // Config 总配置文件
 type Config struct {
-	Account struct {
-		Uin      int64  `yaml:"uin"`
-		Password string `yaml:"password"`
-		Encrypt  bool   `yaml:"encrypt"`
-		Status   int32  `yaml:"status"`
-		ReLogin  struct {
-			Disabled bool `yaml:"disabled"`
-			Delay    uint `yaml:"delay"`
-			MaxTimes uint `yaml:"max-times"`
-			Interval int  `yaml:"interval"`
-		}
-		UseSSOAddress bool `yaml:"use-sso-address"`
-	} `yaml:"account"`
+	Account  Account        `yaml:"account"`
+	Accounts []AccountEntry `yaml:"accounts"`
 
 	Heartbeat struct {
 		Disabled bool `yaml:"disabled"`
 		Interval int  `yaml:"interval"`
 	} `yaml:"heartbeat"`
 
+	Shutdown struct {
+		Timeout int `yaml:"timeout"`
+	} `yaml:"shutdown"`
+
+	Async AsyncConfig `yaml:"async"`
+
 	Message struct {
 		PostFormat          string `yaml:"post-format"`
 		IgnoreInvalidCQCode bool   `yaml:"ignore-invalid-cqcode"`
@@ -56,44 +141,117 @@ type Config struct {
 		ReportSelfMessage   bool   `yaml:"report-self-message"`
 		RemoveReplyAt       bool   `yaml:"remove-reply-at"`
 		ExtraReplyData      bool   `yaml:"extra-reply-data"`
+
+		SendQueue SendQueue `yaml:"send-queue"`
 	} `yaml:"message"`
 
 	Output struct {
 		LogLevel    string `yaml:"log-level"`
 		LogAging    int    `yaml:"log-aging"`
 		LogForceNew bool   `yaml:"log-force-new"`
+		Format      string `yaml:"format"`
 		Debug       bool   `yaml:"debug"`
+
+		Sinks []LogSink `yaml:"sinks"`
 	} `yaml:"output"`
 
+	Storage struct {
+		Root string `yaml:"root"`
+	} `yaml:"storage"`
+
+	State StateConfig `yaml:"state"`
+
 	Servers  []map[string]yaml.Node `yaml:"servers"`
 	Database map[string]yaml.Node   `yaml:"database"`
 }
 
+// StateConfig 会话与设备信息等运行状态的存储配置
+type StateConfig struct {
+	Type      string        `yaml:"type"`
+	Dir       string        `yaml:"dir"`
+	EnvPrefix string        `yaml:"env-prefix"`
+	S3        S3StateConfig `yaml:"s3"`
+}
+
+// S3StateConfig S3 兼容对象存储相关配置
+type S3StateConfig struct {
+	Endpoint  string `yaml:"endpoint"`
+	Bucket    string `yaml:"bucket"`
+	Prefix    string `yaml:"prefix"`
+	Region    string `yaml:"region"`
+	AccessKey string `yaml:"access-key"`
+	SecretKey string `yaml:"secret-key"`
+}
+
 // MiddleWares 通信中间件
 type MiddleWares struct {
-	AccessToken string `yaml:"access-token"`
-	Filter      string `yaml:"filter"`
-	RateLimit   struct {
+	AccessToken  string        `yaml:"access-token"`
+	AccessTokens []AccessToken `yaml:"access-tokens"`
+	Filter       string        `yaml:"filter"`
+	RateLimit    struct {
 		Enabled   bool    `yaml:"enabled"`
 		Frequency float64 `yaml:"frequency"`
 		Bucket    int     `yaml:"bucket"`
 	} `yaml:"rate-limit"`
 }
 
+// AccessToken 带权限范围的访问令牌
+type AccessToken struct {
+	Token        string   `yaml:"token"`
+	AllowActions []string `yaml:"allow-actions"`
+	DenyActions  []string `yaml:"deny-actions"`
+	AllowGroups  []int64  `yaml:"allow-groups"`
+	DenyGroups   []int64  `yaml:"deny-groups"`
+	// 使用该权限范围的客户端证书, 按证书的 CommonName 或 DNS 名称匹配
+	ClientCerts []string `yaml:"client-certs"`
+}
+
+// TLS 通信服务的 TLS 相关配置
+type TLS struct {
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+	// 作为服务器时用于校验客户端证书, 作为客户端时用于校验服务器证书
+	ClientCA string `yaml:"client-ca"`
+}
+
 // HTTPServer HTTP通信相关配置
 type HTTPServer struct {
 	Disabled bool   `yaml:"disabled"`
 	Host     string `yaml:"host"`
 	Port     int    `yaml:"port"`
 	Timeout  int32  `yaml:"timeout"`
-	Post     []struct {
-		URL    string `yaml:"url"`
-		Secret string `yaml:"secret"`
-	}
+	Post     []HTTPPost
+	Queue    HTTPQueue     `yaml:"queue"`
+	Sign     HTTPSignature `yaml:"signature"`
+	TLS      TLS           `yaml:"tls"`
+
+	OneBotVersion int `yaml:"onebot-version"`
 
 	MiddleWares `yaml:"middlewares"`
 }
 
+// HTTPQueue 反向HTTP上报队列相关配置
+type HTTPQueue struct {
+	Enabled    bool `yaml:"enabled"`
+	MaxSize    int  `yaml:"max-size"`
+	MaxBackoff int  `yaml:"max-backoff"`
+}
+
+// HTTPPost 反向HTTP上报地址相关配置
+type HTTPPost struct {
+	URL       string `yaml:"url"`
+	Secret    string `yaml:"secret"`
+	Algorithm string `yaml:"algorithm"`
+	Timestamp bool   `yaml:"timestamp"`
+}
+
+// HTTPSignature HTTP API 请求签名验证相关配置
+type HTTPSignature struct {
+	Secret    string `yaml:"secret"`
+	Algorithm string `yaml:"algorithm"`
+	MaxSkew   int    `yaml:"max-skew"`
+}
+
 // PprofServer pprof性能分析服务器相关配置
 type PprofServer struct {
 	Disabled bool   `yaml:"disabled"`
@@ -101,11 +259,69 @@ type PprofServer struct {
 	Port     int    `yaml:"port"`
 }
 
+// MetricsServer Prometheus 指标服务器相关配置
+type MetricsServer struct {
+	Disabled    bool   `yaml:"disabled"`
+	Host        string `yaml:"host"`
+	Port        int    `yaml:"port"`
+	AccessToken string `yaml:"access-token"`
+}
+
+// AdminServer 管理 API 服务器相关配置
+type AdminServer struct {
+	Disabled    bool     `yaml:"disabled"`
+	Host        string   `yaml:"host"`
+	Port        int      `yaml:"port"`
+	AccessToken string   `yaml:"access-token"`
+	CORS        []string `yaml:"cors"`
+}
+
+// GRPCServer gRPC 通信相关配置
+type GRPCServer struct {
+	Disabled bool   `yaml:"disabled"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+
+	MiddleWares `yaml:"middlewares"`
+}
+
+// BrokerServer 消息队列通信相关配置, 用于 redis, nats 与 mqtt
+type BrokerServer struct {
+	Disabled bool   `yaml:"disabled"`
+	URL      string `yaml:"url"`
+	// 事件发布的 topic 模板, {字段} 将被替换为事件中对应字段的值
+	Topic string `yaml:"topic"`
+	// 接收 API 调用的 topic, 为空时不接收
+	APITopic string `yaml:"api-topic"`
+	// API 调用结果发布的 topic 模板, {字段} 将被替换为请求中对应字段的值
+	ReplyTopic string `yaml:"reply-topic"`
+	ClientID   string `yaml:"client-id"` // 仅 mqtt 有效
+	QoS        byte   `yaml:"qos"`       // 仅 mqtt 有效
+	MaxLen     int64  `yaml:"max-len"`   // 仅 redis 有效
+
+	MiddleWares `yaml:"middlewares"`
+}
+
+// ServerlessServer Serverless 函数调用相关配置
+type ServerlessServer struct {
+	Disabled     bool   `yaml:"disabled"`
+	Host         string `yaml:"host"`
+	Port         int    `yaml:"port"`
+	FlushTimeout int    `yaml:"flush-timeout"`
+
+	OneBotVersion int `yaml:"onebot-version"`
+
+	MiddleWares `yaml:"middlewares"`
+}
+
 // WebsocketServer 正向WS相关配置
 type WebsocketServer struct {
 	Disabled bool   `yaml:"disabled"`
 	Host     string `yaml:"host"`
 	Port     int    `yaml:"port"`
+	TLS      TLS    `yaml:"tls"`
+
+	OneBotVersion int `yaml:"onebot-version"`
 
 	MiddleWares `yaml:"middlewares"`
 }
@@ -117,6 +333,20 @@ type WebsocketReverse struct {
 	API               string `yaml:"api"`
 	Event             string `yaml:"event"`
 	ReconnectInterval int    `yaml:"reconnect-interval"`
+	// 连续重连失败时重连间隔的上限, 单位毫秒
+	MaxReconnectInterval int `yaml:"max-reconnect-interval"`
+	// 主地址无法连接时依次尝试的备用地址
+	Fallback struct {
+		Universal []string `yaml:"universal"`
+		API       []string `yaml:"api"`
+		Event     []string `yaml:"event"`
+	} `yaml:"fallback"`
+	// 断开期间缓存的事件数, 重连后发送, 0 表示不缓存
+	BufferSize int `yaml:"buffer-size"`
+	// 连接 wss 地址时使用的客户端证书
+	TLS TLS `yaml:"tls"`
+
+	OneBotVersion int `yaml:"onebot-version"`
 
 	MiddleWares `yaml:"middlewares"`
 }
@@ -126,9 +356,28 @@ type LevelDBConfig struct {
 	Enable bool `yaml:"enable"`
 }
 
+// SQLiteConfig sqlite3 相关配置
+type SQLiteConfig struct {
+	Enable bool   `yaml:"enable"`
+	File   string `yaml:"file"`
+}
+
+// JournalConfig 事件日志相关配置
+type JournalConfig struct {
+	Enable    bool `yaml:"enable"`
+	Retention int  `yaml:"retention"`
+}
+
+// MemoryDBConfig 内存数据库相关配置
+type MemoryDBConfig struct {
+	Enable bool `yaml:"enable"`
+	Size   int  `yaml:"size"`
+}
+
 var (
 	config *Config
 	once   sync.Once
+	lock   sync.RWMutex
 )
 
 // Get 从默认配置文件路径中获取
@@ -148,72 +397,115 @@ func Get() *Config {
 			os.Exit(0)
 		}
 
-		// type convert tools
-		toInt64 := func(str string) int64 {
-			i, _ := strconv.ParseInt(str, 10, 64)
-			return i
+		config.applyEnvironment()
+	})
+	lock.RLock()
+	defer lock.RUnlock()
+	return config
+}
+
+// Reload 重新读取配置文件, 成功后 Get 将返回新的配置
+//
+// 与 Get 不同, 配置文件不存在或不合法时将返回错误而不是退出
+func Reload() (*Config, error) {
+	c := &Config{}
+	file, err := os.Open(DefaultConfigFile)
+	if err == nil {
+		defer func() { _ = file.Close() }()
+		if err = yaml.NewDecoder(file).Decode(c); err != nil {
+			return nil, errors.Wrap(err, "decode config error")
 		}
+	} else if os.Getenv("GCQ_UIN") == "" {
+		return nil, errors.Wrap(err, "open config error")
+	}
+	c.applyEnvironment()
+	once.Do(func() {})
+	lock.Lock()
+	config = c
+	lock.Unlock()
+	return c, nil
+}
 
-		// load config from environment variable
-		global.SetAtDefault(&config.Account.Uin, toInt64(os.Getenv("GCQ_UIN")), int64(0))
-		global.SetAtDefault(&config.Account.Password, os.Getenv("GCQ_PWD"), "")
-		global.SetAtDefault(&config.Account.Status, int32(toInt64(os.Getenv("GCQ_STATUS"))), int32(0))
-		global.SetAtDefault(&config.Account.ReLogin.Disabled, !global.EnsureBool(os.Getenv("GCQ_RELOGIN"), false), false)
-		global.SetAtDefault(&config.Account.ReLogin.Delay, uint(toInt64(os.Getenv("GCQ_RELOGIN_DELAY"))), uint(0))
-		global.SetAtDefault(&config.Account.ReLogin.MaxTimes, uint(toInt64(os.Getenv("GCQ_RELOGIN_MAX_TIMES"))), uint(0))
-		accessTokenEnv := os.Getenv("GCQ_ACCESS_TOKEN")
-		if os.Getenv("GCQ_HTTP_PORT") != "" {
-			node := &yaml.Node{}
-			httpConf := &HTTPServer{
-				Host: "0.0.0.0",
-				Port: 5700,
-				MiddleWares: MiddleWares{
-					AccessToken: accessTokenEnv,
-				},
-			}
-			global.SetExcludeDefault(&httpConf.Disabled, global.EnsureBool(os.Getenv("GCQ_HTTP_DISABLE"), false), false)
-			global.SetExcludeDefault(&httpConf.Host, os.Getenv("GCQ_HTTP_HOST"), "")
-			global.SetExcludeDefault(&httpConf.Port, int(toInt64(os.Getenv("GCQ_HTTP_PORT"))), 0)
-			if os.Getenv("GCQ_HTTP_POST_URL") != "" {
-				httpConf.Post = append(httpConf.Post, struct {
-					URL    string `yaml:"url"`
-					Secret string `yaml:"secret"`
-				}{os.Getenv("GCQ_HTTP_POST_URL"), os.Getenv("GCQ_HTTP_POST_SECRET")})
-			}
-			_ = node.Encode(httpConf)
-			config.Servers = append(config.Servers, map[string]yaml.Node{"http": *node})
+// applyEnvironment 使用环境变量覆盖配置
+func (c *Config) applyEnvironment() {
+	// type convert tools
+	toInt64 := func(str string) int64 {
+		i, _ := strconv.ParseInt(str, 10, 64)
+		return i
+	}
+
+	// load config from environment variable
+	global.SetAtDefault(&c.Account.Uin, toInt64(os.Getenv("GCQ_UIN")), int64(0))
+	global.SetAtDefault(&c.Account.Password, os.Getenv("GCQ_PWD"), "")
+	global.SetAtDefault(&c.Account.Status, int32(toInt64(os.Getenv("GCQ_STATUS"))), int32(0))
+	global.SetAtDefault(&c.Account.ReLogin.Disabled, !global.EnsureBool(os.Getenv("GCQ_RELOGIN"), false), false)
+	global.SetAtDefault(&c.Account.ReLogin.Delay, uint(toInt64(os.Getenv("GCQ_RELOGIN_DELAY"))), uint(0))
+	global.SetAtDefault(&c.Account.ReLogin.MaxTimes, uint(toInt64(os.Getenv("GCQ_RELOGIN_MAX_TIMES"))), uint(0))
+	global.SetAtDefault(&c.Storage.Root, os.Getenv("GCQ_STORAGE_ROOT"), "")
+	global.SetAtDefault(&c.State.Type, os.Getenv("GCQ_STATE_TYPE"), "")
+	accessTokenEnv := os.Getenv("GCQ_ACCESS_TOKEN")
+	if os.Getenv("GCQ_HTTP_PORT") != "" {
+		node := &yaml.Node{}
+		httpConf := &HTTPServer{
+			Host: "0.0.0.0",
+			Port: 5700,
+			MiddleWares: MiddleWares{
+				AccessToken: accessTokenEnv,
+			},
 		}
-		if os.Getenv("GCQ_WS_PORT") != "" {
-			node := &yaml.Node{}
-			wsServerConf := &WebsocketServer{
-				Host: "0.0.0.0",
-				Port: 6700,
-				MiddleWares: MiddleWares{
-					AccessToken: accessTokenEnv,
-				},
-			}
-			global.SetExcludeDefault(&wsServerConf.Disabled, global.EnsureBool(os.Getenv("GCQ_WS_DISABLE"), false), false)
-			global.SetExcludeDefault(&wsServerConf.Host, os.Getenv("GCQ_WS_HOST"), "")
-			global.SetExcludeDefault(&wsServerConf.Port, int(toInt64(os.Getenv("GCQ_WS_PORT"))), 0)
-			_ = node.Encode(wsServerConf)
-			config.Servers = append(config.Servers, map[string]yaml.Node{"ws": *node})
+		global.SetExcludeDefault(&httpConf.Disabled, global.EnsureBool(os.Getenv("GCQ_HTTP_DISABLE"), false), false)
+		global.SetExcludeDefault(&httpConf.Host, os.Getenv("GCQ_HTTP_HOST"), "")
+		global.SetExcludeDefault(&httpConf.Port, int(toInt64(os.Getenv("GCQ_HTTP_PORT"))), 0)
+		if os.Getenv("GCQ_HTTP_POST_URL") != "" {
+			httpConf.Post = append(httpConf.Post, HTTPPost{URL: os.Getenv("GCQ_HTTP_POST_URL"), Secret: os.Getenv("GCQ_HTTP_POST_SECRET")})
 		}
-		if os.Getenv("GCQ_RWS_API") != "" || os.Getenv("GCQ_RWS_EVENT") != "" || os.Getenv("GCQ_RWS_UNIVERSAL") != "" {
-			node := &yaml.Node{}
-			rwsConf := &WebsocketReverse{
-				MiddleWares: MiddleWares{
-					AccessToken: accessTokenEnv,
-				},
-			}
-			global.SetExcludeDefault(&rwsConf.Disabled, global.EnsureBool(os.Getenv("GCQ_RWS_DISABLE"), false), false)
-			global.SetExcludeDefault(&rwsConf.API, os.Getenv("GCQ_RWS_API"), "")
-			global.SetExcludeDefault(&rwsConf.Event, os.Getenv("GCQ_RWS_EVENT"), "")
-			global.SetExcludeDefault(&rwsConf.Universal, os.Getenv("GCQ_RWS_UNIVERSAL"), "")
-			_ = node.Encode(rwsConf)
-			config.Servers = append(config.Servers, map[string]yaml.Node{"ws-reverse": *node})
+		_ = node.Encode(httpConf)
+		c.Servers = append(c.Servers, map[string]yaml.Node{"http": *node})
+	}
+	if os.Getenv("GCQ_SERVERLESS_PORT") != "" {
+		node := &yaml.Node{}
+		slConf := &ServerlessServer{
+			Host: "0.0.0.0",
+			Port: int(toInt64(os.Getenv("GCQ_SERVERLESS_PORT"))),
+			MiddleWares: MiddleWares{
+				AccessToken: accessTokenEnv,
+			},
 		}
-	})
-	return config
+		if host := os.Getenv("GCQ_SERVERLESS_HOST"); host != "" {
+			slConf.Host = host
+		}
+		_ = node.Encode(slConf)
+		c.Servers = append(c.Servers, map[string]yaml.Node{"serverless": *node})
+	}
+	if os.Getenv("GCQ_WS_PORT") != "" {
+		node := &yaml.Node{}
+		wsServerConf := &WebsocketServer{
+			Host: "0.0.0.0",
+			Port: 6700,
+			MiddleWares: MiddleWares{
+				AccessToken: accessTokenEnv,
+			},
+		}
+		global.SetExcludeDefault(&wsServerConf.Disabled, global.EnsureBool(os.Getenv("GCQ_WS_DISABLE"), false), false)
+		global.SetExcludeDefault(&wsServerConf.Host, os.Getenv("GCQ_WS_HOST"), "")
+		global.SetExcludeDefault(&wsServerConf.Port, int(toInt64(os.Getenv("GCQ_WS_PORT"))), 0)
+		_ = node.Encode(wsServerConf)
+		c.Servers = append(c.Servers, map[string]yaml.Node{"ws": *node})
+	}
+	if os.Getenv("GCQ_RWS_API") != "" || os.Getenv("GCQ_RWS_EVENT") != "" || os.Getenv("GCQ_RWS_UNIVERSAL") != "" {
+		node := &yaml.Node{}
+		rwsConf := &WebsocketReverse{
+			MiddleWares: MiddleWares{
+				AccessToken: accessTokenEnv,
+			},
+		}
+		global.SetExcludeDefault(&rwsConf.Disabled, global.EnsureBool(os.Getenv("GCQ_RWS_DISABLE"), false), false)
+		global.SetExcludeDefault(&rwsConf.API, os.Getenv("GCQ_RWS_API"), "")
+		global.SetExcludeDefault(&rwsConf.Event, os.Getenv("GCQ_RWS_EVENT"), "")
+		global.SetExcludeDefault(&rwsConf.Universal, os.Getenv("GCQ_RWS_UNIVERSAL"), "")
+		_ = node.Encode(rwsConf)
+		c.Servers = append(c.Servers, map[string]yaml.Node{"ws-reverse": *node})
+	}
 }
 
 // getCurrentPath 获取当前文件的路径，直接返回string
@@ -235,6 +527,11 @@ func generateConfig() {
 > 2: 正向 Websocket 通信
 > 3: 反向 Websocket 通信
 > 4: pprof 性能分析服务器
+> 5: Serverless 函数调用
+> 6: Prometheus 指标
+> 7: 管理 API
+> 8: gRPC 通信
+> 9: 消息队列 (Redis Streams/NATS/MQTT)
 请输入你需要的编号，可输入多个，同一编号也可输入多个(如: 233)
 您的选择是:`)
 	input := bufio.NewReader(os.Stdin)
@@ -252,6 +549,16 @@ func generateConfig() {
 			sb.WriteString(wsReverseDefault)
 		case '4':
 			sb.WriteString(pprofDefault)
+		case '5':
+			sb.WriteString(serverlessDefault)
+		case '6':
+			sb.WriteString(metricsDefault)
+		case '7':
+			sb.WriteString(adminDefault)
+		case '8':
+			sb.WriteString(grpcDefault)
+		case '9':
+			sb.WriteString(brokerDefault)
 		}
 	}
 	_ = os.WriteFile("config.yml", []byte(sb.String()), 0o644)
@@ -268,6 +575,15 @@ const httpDefault = `  # HTTP 通信设置
       # 反向HTTP超时时间, 单位秒
       # 最小值为5，小于5将会忽略本项设置
       timeout: 5
+      # TLS 证书, 设置后使用 HTTPS/WSS, 证书文件修改后自动重新加载
+      tls:
+        cert: ''
+        key: ''
+        # 校验客户端证书的 CA, 证书在 access-tokens 的 client-certs 中的客户端无需访问令牌
+        # 未设置访问令牌时拒绝未提供证书的客户端, 通过校验的客户端不受限制
+        client-ca: ''
+      # OneBot 协议版本, 可选 11, 12
+      onebot-version: 11
       middlewares:
         <<: *default # 引用默认中间件
       # 反向HTTP POST地址列表
@@ -276,6 +592,20 @@ const httpDefault = `  # HTTP 通信设置
       #  secret: ''           # 密钥
       #- url: 127.0.0.1:5701 # 地址
       #  secret: ''          # 密钥
+      #  algorithm: sha1     # 签名算法, 可选 sha1, sha256, sha512, 其他值将导致该 HTTP 服务不会启动
+      #  timestamp: false    # 是否发送 X-Timestamp 并将其一同签名, 用于防止重放
+      # 持久化上报队列, 启用后上报失败的事件将保存到磁盘并按顺序重新投递
+      queue:
+        enabled: false
+        max-size: 10000  # 每个上报地址最多保存的事件数, 0 为不限制
+        max-backoff: 60  # 重试的最大间隔, 单位秒
+      # API 请求签名验证, 设置 secret 后所有 API 请求都需要携带
+      # X-Timestamp 与 X-Signature 请求头, 签名内容为 "<方法>\n<路径>\n<时间戳>.<请求体>"
+      # GET 请求的请求体为 URL 中的查询字符串
+      signature:
+        secret: ''
+        algorithm: sha256 # 签名算法, 可选 sha1, sha256, sha512, 其他值将导致该 HTTP 服务不会启动
+        max-skew: 300     # 允许的时间戳误差, 单位秒
 `
 
 const wsDefault = `  # 正向WS设置
@@ -284,6 +614,14 @@ const wsDefault = `  # 正向WS设置
       host: 127.0.0.1
       # 正向WS服务器监听端口
       port: 6700
+      # TLS 证书, 设置后使用 HTTPS/WSS, 证书文件修改后自动重新加载
+      tls:
+        cert: ''
+        key: ''
+        # 校验客户端证书的 CA, 证书在 access-tokens 的 client-certs 中的客户端无需访问令牌
+        # 未设置访问令牌时拒绝未提供证书的客户端, 通过校验的客户端不受限制
+        client-ca: ''
+      onebot-version: 11
       middlewares:
         <<: *default # 引用默认中间件
 `
@@ -297,8 +635,23 @@ const wsReverseDefault = `  # 反向WS设置
       api: ws://your_websocket_api.server
       # 反向WS Event 地址
       event: ws://your_websocket_event.server
-      # 重连间隔 单位毫秒
+      # 重连间隔 单位毫秒, 连续失败时翻倍直到 max-reconnect-interval
       reconnect-interval: 3000
+      max-reconnect-interval: 60000
+      # 备用地址, 上面的地址无法连接时依次尝试
+      fallback:
+        universal: [ ]
+        api: [ ]
+        event: [ ]
+      # 断开期间缓存的事件数, 重连后发送, 0 表示不缓存
+      buffer-size: 1000
+      # 连接 wss 地址时使用的客户端证书
+      tls:
+        cert: ''
+        key: ''
+        # 校验服务器证书的 CA, 为空时使用系统 CA
+        client-ca: ''
+      onebot-version: 11
       middlewares:
         <<: *default # 引用默认中间件
 `
@@ -312,3 +665,93 @@ const pprofDefault = `  # pprof 性能分析服务器, 一般情况下不需要
       # pprof服务器监听端口
       port: 7700
 `
+
+const serverlessDefault = `  # Serverless 函数调用设置
+  # 启用后将仅使用 session.token 与 device.json 恢复会话, 不会进行交互式登录
+  - serverless:
+      # 函数入口监听地址
+      host: 0.0.0.0
+      # 函数入口监听端口
+      port: 9000
+      # 每次调用结束前等待事件上报完成的最长时间, 单位毫秒
+      flush-timeout: 3000
+      onebot-version: 11
+      middlewares:
+        <<: *default # 引用默认中间件
+`
+
+const metricsDefault = `  # Prometheus 指标服务器, 在 /metrics 导出 API 调用, 事件分发,
+  # 上报重试, WebSocket 连接数与重连次数等指标
+  - metrics:
+      # 指标服务器监听地址
+      host: 127.0.0.1
+      # 指标服务器监听端口
+      port: 9100
+      # 访问密钥, 设置后需使用 Authorization: Bearer <access-token> 访问
+      access-token: ''
+`
+
+const adminDefault = `  # 管理 API 服务器, 提供重启, 读写配置文件与提交登录验证等接口
+  # 注意: 管理 API 可以修改账号密码与访问密钥, 请务必设置 access-token
+  - admin:
+      # 管理 API 服务器监听地址
+      host: 127.0.0.1
+      # 管理 API 服务器监听端口
+      port: 9200
+      # 访问密钥, 与其他通信服务的访问密钥相互独立, 未设置时仅可监听本机地址
+      access-token: ''
+      # 允许跨域访问的来源, 如 https://panel.example.com, * 表示允许所有来源, 均不允许携带 Cookie 等凭据
+      cors: [ ]
+`
+
+const grpcDefault = `  # gRPC 通信设置, 协议定义见 server/pb/onebot.proto
+  - grpc:
+      # gRPC 服务器监听地址
+      host: 127.0.0.1
+      # gRPC 服务器监听端口
+      port: 6800
+      middlewares:
+        <<: *default # 引用默认中间件
+`
+
+const brokerDefault = `  # 消息队列通信设置, 将事件发布到 Redis Streams, NATS 或 MQTT, 按需删除不使用的服务
+  # topic 中的 {字段} 将被替换为事件中对应字段的值, 事件中不存在的字段替换为 _
+  - redis:
+      # Redis 地址, 格式为 redis://[:password@]host:port/db
+      url: redis://127.0.0.1:6379/0
+      # 事件发布的 Stream
+      topic: 'qq:{self_id}:{post_type}'
+      # 每个 Stream 保留的最大事件数 (近似值), 0 表示不限制
+      max-len: 10000
+      # 接收 API 调用的 Stream, 为空时不接收
+      api-topic: ''
+      # API 调用结果发布的 Stream, {字段} 将被替换为请求中对应字段的值
+      reply-topic: 'qq:api:reply'
+      middlewares:
+        <<: *default # 引用默认中间件
+  - nats:
+      # NATS 地址, 格式为 nats://[user:password@]host:port
+      url: nats://127.0.0.1:4222
+      # 事件发布的 subject
+      topic: 'qq.{self_id}.{post_type}.{group_id}'
+      # 接收 API 调用的 subject, 为空时不接收
+      api-topic: ''
+      # API 调用结果发布的 subject, 使用 NATS request 发送的请求将直接回复
+      reply-topic: 'qq.api.reply'
+      middlewares:
+        <<: *default # 引用默认中间件
+  - mqtt:
+      # MQTT 服务器地址, 格式为 tcp://[user:password@]host:port, 也支持 ssl:// 与 ws://
+      url: tcp://127.0.0.1:1883
+      # 客户端 ID, 为空时随机生成
+      client-id: ''
+      qos: 0
+      # 事件发布的 topic
+      topic: 'qq/{self_id}/{post_type}/{group_id}'
+      # 接收 API 调用的 topic, 为空时不接收
+      api-topic: ''
+      # API 调用结果发布的 topic
+      reply-topic: 'qq/api/reply'
+      middlewares:
+        <<: *default # 引用默认中间件
+`
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ReadFile 返回配置文件的原始内容
+func ReadFile() ([]byte, error) {
+	data, err := os.ReadFile(DefaultConfigFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "read config error")
+	}
+	return data, nil
+}
+
+// WriteFile 校验 data 为合法的配置后写入配置文件
+//
+// 先写入同目录下的临时文件再替换, 以免写入中断时损坏配置文件
+func WriteFile(data []byte) error {
+	if err := yaml.Unmarshal(data, new(Config)); err != nil {
+		return errors.Wrap(err, "decode config error")
+	}
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(DefaultConfigFile); err == nil {
+		mode = info.Mode().Perm()
+	}
+	tmp := DefaultConfigFile + ".tmp"
+	if err := os.WriteFile(tmp, data, mode); err != nil {
+		return errors.Wrap(err, "write config error")
+	}
+	if err := os.Rename(tmp, DefaultConfigFile); err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrap(err, "write config error")
+	}
+	return nil
+}
+
+// Edit 以 yaml.Node 的形式修改配置文件, 配置文件中的注释与锚点将被保留
+//
+// fn 的参数为配置文件最外层的 mapping 节点
+func Edit(fn func(root *yaml.Node) error) error {
+	data, err := ReadFile()
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return errors.Wrap(err, "decode config error")
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	if err = fn(doc.Content[0]); err != nil {
+		return err
+	}
+	clearMergeTags(&doc)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err = enc.Encode(&doc); err != nil {
+		return errors.Wrap(err, "encode config error")
+	}
+	return WriteFile(buf.Bytes())
+}
+
+// MappingValue 返回 mapping 节点中 key 对应的值, 不存在时创建一个空的 mapping
+//
+// 值为别名时返回其引用的节点, 值为空时将其替换为 mapping
+func MappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+		v := node.Content[i+1]
+		if v.Kind == yaml.AliasNode {
+			return v.Alias
+		}
+		if v.Kind == yaml.ScalarNode && v.Tag == "!!null" {
+			v.Kind, v.Tag, v.Value = yaml.MappingNode, "!!map", ""
+		}
+		return v
+	}
+	v := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, v)
+	return v
+}
+
+// SetValue 将 mapping 节点中 path 对应的值设置为 value, 不存在的键将被创建
+func SetValue(node *yaml.Node, value interface{}, path ...string) error {
+	for _, key := range path[:len(path)-1] {
+		node = MappingValue(node, key)
+	}
+	var v yaml.Node
+	if err := v.Encode(value); err != nil {
+		return errors.Wrap(err, "encode config error")
+	}
+	key := path[len(path)-1]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			old := node.Content[i+1]
+			// 保留原有的注释
+			v.HeadComment, v.LineComment, v.FootComment = old.HeadComment, old.LineComment, old.FootComment
+			node.Content[i+1] = &v
+			return nil
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, &v)
+	return nil
+}
+
+// ServerNode 返回 servers 中第一个 kind 类型通信服务的配置节点, 不存在时添加一个
+//
+// 新添加的服务引用默认中间件锚点 (如果存在)
+func ServerNode(root *yaml.Node, kind string) *yaml.Node {
+	servers := MappingValue(root, "servers")
+	if servers.Kind != yaml.SequenceNode {
+		servers.Kind, servers.Tag, servers.Content = yaml.SequenceNode, "!!seq", nil
+	}
+	for _, item := range servers.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			if item.Content[i].Value == kind {
+				return MappingValue(item, kind)
+			}
+		}
+	}
+	item := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	servers.Content = append(servers.Content, item)
+	conf := MappingValue(item, kind)
+	if anchor := findAnchor(root, "default"); anchor != nil {
+		middlewares := MappingValue(conf, "middlewares")
+		middlewares.Content = append(middlewares.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "<<"},
+			&yaml.Node{Kind: yaml.AliasNode, Value: anchor.Anchor, Alias: anchor})
+	}
+	return conf
+}
+
+// clearMergeTags 清除合并键的标签, 否则编码时将输出为 "!!merge <<"
+func clearMergeTags(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!merge" {
+		node.Tag = ""
+	}
+	for _, c := range node.Content {
+		clearMergeTags(c)
+	}
+}
+
+func findAnchor(node *yaml.Node, name string) *yaml.Node {
+	if node.Anchor == name {
+		return node
+	}
+	for _, c := range node.Content {
+		if n := findAnchor(c, name); n != nil {
+			return n
+		}
+	}
+	return nil
+}
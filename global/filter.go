@@ -1,8 +1,11 @@
 package global
 
 import (
+	"encoding/json"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
@@ -12,8 +15,36 @@ type Filter interface {
 	Eval(payload gjson.Result) bool
 }
 
+// explainer 由包含子过滤器的操作符实现, 用于找出拒绝 payload 的节点
+type explainer interface {
+	explain(payload gjson.Result, path string) (bool, string)
+}
+
+// Explain 对 payload 执行过滤, 未通过时返回拒绝该 payload 的节点路径, 如 "group_id/.in"
+func Explain(filter Filter, payload gjson.Result) (bool, string) {
+	return explain(filter, payload, "")
+}
+
+func explain(filter Filter, payload gjson.Result, path string) (bool, string) {
+	if e, ok := filter.(explainer); ok {
+		return e.explain(payload, path)
+	}
+	if filter.Eval(payload) {
+		return true, ""
+	}
+	return false, path
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
 type operationNode struct {
 	key    string
+	name   string // 过滤规则中的键, 用于 Explain
 	filter Filter
 }
 
@@ -34,6 +65,16 @@ func (op *notOperator) Eval(payload gjson.Result) bool {
 	return !op.operand.Eval(payload)
 }
 
+func (op *notOperator) explain(payload gjson.Result, path string) (bool, string) {
+	if op.Eval(payload) {
+		return true, ""
+	}
+	if path == "" {
+		path = ".not"
+	}
+	return false, path
+}
+
 // andOperator 定义了过滤器中And操作符
 type andOperator struct {
 	operands []operationNode
@@ -52,19 +93,19 @@ func newAndOp(argument gjson.Result) Filter {
 			//       "bar": "baz"
 			//   }
 			opKey := key.Str[1:]
-			op.operands = append(op.operands, operationNode{"", Generate(opKey, value)})
+			op.operands = append(op.operands, operationNode{"", key.Str, Generate(opKey, value)})
 		case value.IsObject():
 			// is an normal key with an object as the value
 			//   "foo": {
 			//       ".bar": "baz"
 			//   }
 			opKey := key.String()
-			op.operands = append(op.operands, operationNode{opKey, Generate("and", value)})
+			op.operands = append(op.operands, operationNode{opKey, opKey, Generate("and", value)})
 		default:
 			// is an normal key with a non-object as the value
 			//   "foo": "bar"
 			opKey := key.String()
-			op.operands = append(op.operands, operationNode{opKey, Generate("eq", value)})
+			op.operands = append(op.operands, operationNode{opKey, opKey, Generate("eq", value)})
 		}
 		return true
 	})
@@ -91,6 +132,19 @@ func (op *andOperator) Eval(payload gjson.Result) bool {
 	return res
 }
 
+func (op *andOperator) explain(payload gjson.Result, path string) (bool, string) {
+	for _, operand := range op.operands {
+		val := payload
+		if len(operand.key) != 0 {
+			val = payload.Get(operand.key)
+		}
+		if ok, node := explain(operand.filter, val, joinPath(path, operand.name)); !ok {
+			return false, node
+		}
+	}
+	return true, ""
+}
+
 // orOperator 定义了过滤器中Or操作符
 type orOperator struct {
 	operands []Filter
@@ -120,6 +174,16 @@ func (op *orOperator) Eval(payload gjson.Result) bool {
 	return res
 }
 
+func (op *orOperator) explain(payload gjson.Result, path string) (bool, string) {
+	if op.Eval(payload) {
+		return true, ""
+	}
+	if path == "" {
+		path = ".or"
+	}
+	return false, path
+}
+
 // eqOperator 定义了过滤器中Equal操作符
 type eqOperator struct {
 	operand string
@@ -219,6 +283,222 @@ func (op *regexOperator) Eval(payload gjson.Result) bool {
 	return op.regex.MatchString(payload.String())
 }
 
+// compareOperator 定义了过滤器中gt,lt,gte,lte操作符
+type compareOperator struct {
+	operand float64
+	cmp     func(a, b float64) bool
+}
+
+func newCompareOp(opName string, argument gjson.Result) Filter {
+	if argument.Type != gjson.Number {
+		panic("the argument of '" + opName + "' operator must be a number")
+	}
+	op := &compareOperator{operand: argument.Float()}
+	switch opName {
+	case "gt":
+		op.cmp = func(a, b float64) bool { return a > b }
+	case "lt":
+		op.cmp = func(a, b float64) bool { return a < b }
+	case "gte":
+		op.cmp = func(a, b float64) bool { return a >= b }
+	default:
+		op.cmp = func(a, b float64) bool { return a <= b }
+	}
+	return op
+}
+
+// Eval 对payload执行数值比较过滤, payload 不是数字时不通过
+func (op *compareOperator) Eval(payload gjson.Result) bool {
+	switch payload.Type {
+	case gjson.Number:
+		return op.cmp(payload.Float(), op.operand)
+	case gjson.String:
+		v, err := strconv.ParseFloat(payload.Str, 64)
+		return err == nil && op.cmp(v, op.operand)
+	default:
+		return false
+	}
+}
+
+// existsOperator 定义了过滤器中Exists操作符
+type existsOperator struct {
+	operand bool
+}
+
+func newExistsOp(argument gjson.Result) Filter {
+	if argument.Type != gjson.True && argument.Type != gjson.False {
+		panic("the argument of 'exists' operator must be a boolean")
+	}
+	return &existsOperator{operand: argument.Bool()}
+}
+
+// Eval 对payload执行Exists过滤, 值为 null 时视为不存在
+func (op *existsOperator) Eval(payload gjson.Result) bool {
+	return (payload.Exists() && payload.Type != gjson.Null) == op.operand
+}
+
+// affixOperator 定义了过滤器中StartsWith,EndsWith操作符
+type affixOperator struct {
+	operand string
+	suffix  bool
+}
+
+func newAffixOp(opName string, argument gjson.Result) Filter {
+	if argument.IsArray() || argument.IsObject() {
+		panic("the argument of '" + opName + "' operator must be a string")
+	}
+	return &affixOperator{operand: argument.String(), suffix: opName == "endswith"}
+}
+
+// Eval 对payload执行StartsWith或EndsWith过滤
+func (op *affixOperator) Eval(payload gjson.Result) bool {
+	if op.suffix {
+		return strings.HasSuffix(payload.String(), op.operand)
+	}
+	return strings.HasPrefix(payload.String(), op.operand)
+}
+
+// eventTime 返回 payload 对应的时间: 数字视为 Unix 时间戳,
+// 对象使用其中的 time 字段, 其余情况使用当前时间
+func eventTime(payload gjson.Result) time.Time {
+	switch {
+	case payload.Type == gjson.Number:
+		return time.Unix(payload.Int(), 0)
+	case payload.IsObject() && payload.Get("time").Type == gjson.Number:
+		return time.Unix(payload.Get("time").Int(), 0)
+	default:
+		return time.Now()
+	}
+}
+
+// timeOfDayOperator 定义了过滤器中TimeOfDay操作符
+type timeOfDayOperator struct {
+	start, end int // 一天中的分钟数
+}
+
+func parseClock(s string) (int, bool) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+func newTimeOfDayOp(argument gjson.Result) Filter {
+	parts := strings.Split(argument.String(), "-")
+	if len(parts) == 2 {
+		start, ok1 := parseClock(parts[0])
+		end, ok2 := parseClock(parts[1])
+		if ok1 && ok2 {
+			return &timeOfDayOperator{start: start, end: end}
+		}
+	}
+	panic("the argument of 'time_of_day' operator must be like '09:00-18:00'")
+}
+
+// Eval 对payload执行TimeOfDay过滤, 结束时间早于开始时间时表示跨越零点
+func (op *timeOfDayOperator) Eval(payload gjson.Result) bool {
+	t := eventTime(payload)
+	m := t.Hour()*60 + t.Minute()
+	if op.start <= op.end {
+		return m >= op.start && m < op.end
+	}
+	return m >= op.start || m < op.end
+}
+
+// weekdayOperator 定义了过滤器中Weekday操作符
+type weekdayOperator struct {
+	days [7]bool
+}
+
+func newWeekdayOp(argument gjson.Result) Filter {
+	op := new(weekdayOperator)
+	days := []gjson.Result{argument}
+	if argument.IsArray() {
+		days = argument.Array()
+	}
+	for _, d := range days {
+		n := d.Int()
+		if d.Type != gjson.Number || n < 1 || n > 7 {
+			panic("the argument of 'weekday' operator must be numbers between 1 and 7")
+		}
+		op.days[n%7] = true
+	}
+	return op
+}
+
+// Eval 对payload执行Weekday过滤, 1 为星期一, 7 为星期日
+func (op *weekdayOperator) Eval(payload gjson.Result) bool {
+	return op.days[eventTime(payload).Weekday()]
+}
+
+// segmentOperator 定义了过滤器中Segment操作符
+type segmentOperator struct {
+	operand Filter
+}
+
+func newSegmentOp(argument gjson.Result) Filter {
+	if argument.IsObject() {
+		return &segmentOperator{operand: Generate("and", argument)}
+	}
+	if argument.IsArray() {
+		panic("the argument of 'segment' operator must be a string or an object")
+	}
+	return &segmentOperator{operand: &andOperator{
+		operands: []operationNode{{"type", "type", newEqOp(argument)}},
+	}}
+}
+
+// Eval 对payload执行Segment过滤, 消息中任意一个消息段匹配时通过
+//
+// payload 为字符串时按 CQ码 解析为消息段
+func (op *segmentOperator) Eval(payload gjson.Result) bool {
+	if payload.Type == gjson.String {
+		payload = parseSegments(payload.Str)
+	}
+	if !payload.IsArray() {
+		return false
+	}
+	matched := false
+	payload.ForEach(func(_, seg gjson.Result) bool {
+		matched = op.operand.Eval(seg)
+		return !matched
+	})
+	return matched
+}
+
+var cqCodeRegex = regexp.MustCompile(`\[CQ:([\w.\-]+)((?:,[^,\]]*)*)]`)
+
+var cqUnescaper = strings.NewReplacer("&#44;", ",", "&#91;", "[", "&#93;", "]", "&amp;", "&")
+
+// parseSegments 将含有 CQ码 的字符串解析为数组格式的消息
+func parseSegments(raw string) gjson.Result {
+	var segments []map[string]interface{}
+	text := func(s string) {
+		if s != "" {
+			segments = append(segments, map[string]interface{}{
+				"type": "text",
+				"data": map[string]string{"text": cqUnescaper.Replace(s)},
+			})
+		}
+	}
+	last := 0
+	for _, loc := range cqCodeRegex.FindAllStringSubmatchIndex(raw, -1) {
+		text(raw[last:loc[0]])
+		last = loc[1]
+		data := make(map[string]string)
+		for _, kv := range strings.Split(raw[loc[4]:loc[5]], ",") {
+			if i := strings.IndexByte(kv, '='); i > 0 {
+				data[kv[:i]] = cqUnescaper.Replace(kv[i+1:])
+			}
+		}
+		segments = append(segments, map[string]interface{}{"type": raw[loc[2]:loc[3]], "data": data})
+	}
+	text(raw[last:])
+	b, _ := json.Marshal(segments)
+	return gjson.ParseBytes(b)
+}
+
 // Generate 根据给定操作符名opName及操作符参数argument创建一个过滤器实例
 func Generate(opName string, argument gjson.Result) Filter {
 	switch opName {
@@ -238,6 +518,18 @@ func Generate(opName string, argument gjson.Result) Filter {
 		return newContainOp(argument)
 	case "regex":
 		return newRegexOp(argument)
+	case "gt", "lt", "gte", "lte":
+		return newCompareOp(opName, argument)
+	case "exists":
+		return newExistsOp(argument)
+	case "startswith", "endswith":
+		return newAffixOp(opName, argument)
+	case "time_of_day":
+		return newTimeOfDayOp(argument)
+	case "weekday":
+		return newWeekdayOp(argument)
+	case "segment":
+		return newSegmentOp(argument)
 	default:
 		panic("the operator " + opName + " is not supported")
 	}
@@ -17,9 +17,20 @@ import (
 
 	"github.com/Mrs4s/MiraiGo/utils"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/codec"
 )
 
-const (
+// DefaultStorageRoot go-cqhttp默认使用的存储根目录
+const DefaultStorageRoot = "/mnt"
+
+var (
+	// StorageRoot go-cqhttp使用的存储根目录
+	StorageRoot = DefaultStorageRoot
+	// DataPath go-cqhttp使用的数据目录
+	DataPath = "/mnt/data"
+	// LogPath go-cqhttp使用的日志目录
+	LogPath = "/mnt/logs"
 	// ImagePath go-cqhttp使用的图片缓存目录
 	ImagePath = "/mnt/data/images"
 	// ImagePathOld 兼容旧版go-cqhttp使用的图片缓存目录
@@ -34,6 +45,25 @@ const (
 	CachePath = "/mnt/data/cache"
 )
 
+// SetStorageRoot 设置存储根目录, 所有数据与日志目录都将以此为基准重新计算
+//
+// root 为空时使用 DefaultStorageRoot
+func SetStorageRoot(root string) {
+	if root == "" {
+		root = DefaultStorageRoot
+	}
+	StorageRoot = root
+	DataPath = path.Join(root, "data")
+	LogPath = path.Join(root, "logs")
+	ImagePath = path.Join(DataPath, "images")
+	ImagePathOld = path.Join(DataPath, "image")
+	VoicePath = path.Join(DataPath, "voices")
+	VoicePathOld = path.Join(DataPath, "record")
+	VideoPath = path.Join(DataPath, "videos")
+	CachePath = path.Join(DataPath, "cache")
+	codec.SetCachePath(CachePath)
+}
+
 var (
 	// ErrSyntax Path语法错误时返回的错误
 	ErrSyntax = errors.New("syntax error")
@@ -144,8 +174,12 @@ func ReadAddrFile(path string) []*net.TCPAddr {
 	if err != nil {
 		return nil
 	}
-	str := string(d)
-	lines := strings.Split(str, "\n")
+	return ParseAddrs(d)
+}
+
+// ParseAddrs 从给定内容中解析合法的IP地址与端口,每个IP地址以换行符"\n"作为分隔
+func ParseAddrs(d []byte) []*net.TCPAddr {
+	lines := strings.Split(string(d), "\n")
 	var ret []*net.TCPAddr
 	for _, l := range lines {
 		ip := strings.Split(strings.TrimSpace(l), ":")
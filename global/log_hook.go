@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/sirupsen/logrus"
+	easy "github.com/t-tomalak/logrus-easy-formatter"
 )
 
 // LocalHook logrus本地钩子
@@ -106,6 +107,15 @@ func (hook *LocalHook) SetWriter(writer io.Writer) {
 	hook.writer = writer
 }
 
+// SetLevels 设置hook级别
+//
+// logrus 仅在 AddHook 时读取 Levels, 修改后需要重新注册该钩子
+func (hook *LocalHook) SetLevels(levels ...logrus.Level) {
+	hook.lock.Lock()
+	defer hook.lock.Unlock()
+	hook.levels = levels
+}
+
 // SetPath 设置日志写入路径
 func (hook *LocalHook) SetPath(path string) {
 	hook.lock.Lock()
@@ -133,6 +143,20 @@ func NewLocalHook(args interface{}, formatter logrus.Formatter, levels ...logrus
 	return hook
 }
 
+// NewLogFormatter 返回 output.format 对应的日志格式
+//
+// json 格式每行输出一个 JSON 对象, 包含 time, level, msg 与
+// self_id, group_id, user_id, action, echo, message_id, request_id 等字段
+func NewLogFormatter(format string) logrus.Formatter {
+	if format == "json" {
+		return &logrus.JSONFormatter{TimestampFormat: "2006-01-02T15:04:05.000Z07:00"}
+	}
+	return &easy.Formatter{
+		TimestampFormat: "2006-01-02 15:04:05",
+		LogFormat:       "[%time%] [%lvl%]: %msg% \n",
+	}
+}
+
 // GetLogLevel 获取日志等级
 //
 // 可能的值有
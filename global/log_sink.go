@@ -0,0 +1,271 @@
+package global
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SyslogFormatter 在 Formatter 的输出前添加 RFC 3164 格式的 syslog 头
+type SyslogFormatter struct {
+	Formatter logrus.Formatter
+	Tag       string
+
+	hostname string
+}
+
+// NewSyslogFormatter 返回以 tag 标识程序的 SyslogFormatter, facility 为 user
+func NewSyslogFormatter(formatter logrus.Formatter, tag string) *SyslogFormatter {
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "localhost"
+	}
+	if tag == "" {
+		tag = "go-cqhttp"
+	}
+	return &SyslogFormatter{Formatter: formatter, Tag: tag, hostname: hostname}
+}
+
+// syslogSeverity 将 logrus 的日志等级转换为 syslog 的 severity
+func syslogSeverity(level logrus.Level) int {
+	switch level {
+	case logrus.PanicLevel:
+		return 0
+	case logrus.FatalLevel:
+		return 2
+	case logrus.ErrorLevel:
+		return 3
+	case logrus.WarnLevel:
+		return 4
+	case logrus.InfoLevel:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// Format ref: logrus/formatter.go impl Formatter interface
+func (f *SyslogFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	b, err := f.Formatter.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("<%d>%s %s %s[%d]: ",
+		8+syslogSeverity(entry.Level), entry.Time.Format(time.Stamp), f.hostname, f.Tag, os.Getpid())
+	return append([]byte(header), b...), nil
+}
+
+// NetWriter 通过 TCP 或 UDP 发送日志, 写入失败时重新连接一次
+//
+// 写入不会阻塞, 缓冲区已满时新的日志将被丢弃.
+// 连接失败后 5 秒内的日志将被丢弃, 失败的信息输出到标准错误, 以免再次写入日志.
+type NetWriter struct {
+	network string
+	addr    string
+
+	conn  net.Conn
+	retry time.Time // 连接失败后, 在此之前不再尝试连接
+
+	lines chan []byte
+	stop  chan struct{}
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewNetWriter 返回发送到 addr 的 NetWriter, 连接在第一次写入时建立
+func NewNetWriter(network, addr string) *NetWriter {
+	w := &NetWriter{
+		network: network,
+		addr:    addr,
+		lines:   make(chan []byte, 1000),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Write ref: io.Writer
+func (w *NetWriter) Write(p []byte) (int, error) {
+	line := append([]byte(nil), p...)
+	select {
+	case <-w.stop:
+	case w.lines <- line:
+	default:
+	}
+	return len(p), nil
+}
+
+func (w *NetWriter) run() {
+	defer close(w.done)
+	for {
+		select {
+		case line := <-w.lines:
+			w.send(line)
+		case <-w.stop:
+			for {
+				select {
+				case line := <-w.lines:
+					w.send(line)
+				default:
+					if w.conn != nil {
+						_ = w.conn.Close()
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// send 发送一条日志, 失败时重新连接并重试一次
+func (w *NetWriter) send(line []byte) {
+	if w.conn == nil && time.Now().Before(w.retry) {
+		return
+	}
+	err := w.write(line)
+	if err != nil {
+		err = w.write(line)
+	}
+	if err != nil {
+		w.retry = time.Now().Add(time.Second * 5)
+		fmt.Fprintf(os.Stderr, "发送日志到 %v 失败, 5 秒内的日志将被丢弃: %v\n", w.addr, err)
+	}
+}
+
+func (w *NetWriter) write(p []byte) error {
+	if w.conn == nil {
+		conn, err := net.DialTimeout(w.network, w.addr, time.Second*5)
+		if err != nil {
+			return err
+		}
+		w.conn = conn
+	}
+	_ = w.conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
+	_, err := w.conn.Write(p)
+	if err != nil {
+		_ = w.conn.Close()
+		w.conn = nil
+	}
+	return err
+}
+
+// Close 发送缓冲区中剩余的日志并关闭连接
+func (w *NetWriter) Close() error {
+	w.once.Do(func() { close(w.stop) })
+	<-w.done
+	return nil
+}
+
+// HTTPBatchWriter 将日志按批次 POST 到 URL, 请求体为按行拼接的日志
+//
+// 写入不会阻塞, 缓冲区已满时新的日志将被丢弃.
+// 发送失败的信息输出到标准错误, 以免再次写入日志.
+type HTTPBatchWriter struct {
+	url         string
+	contentType string
+	size        int
+	interval    time.Duration
+	client      *http.Client
+
+	lines chan []byte
+	stop  chan struct{}
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewHTTPBatchWriter 返回每 size 条或每 interval 发送一次的 HTTPBatchWriter
+func NewHTTPBatchWriter(url, contentType string, size int, interval time.Duration) *HTTPBatchWriter {
+	if size <= 0 {
+		size = 100
+	}
+	if interval <= 0 {
+		interval = time.Second * 5
+	}
+	w := &HTTPBatchWriter{
+		url:         url,
+		contentType: contentType,
+		size:        size,
+		interval:    interval,
+		client:      &http.Client{Timeout: time.Second * 10},
+		lines:       make(chan []byte, size*10),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Write ref: io.Writer
+func (w *HTTPBatchWriter) Write(p []byte) (int, error) {
+	line := append([]byte(nil), p...)
+	select {
+	case <-w.stop:
+	case w.lines <- line:
+	default:
+	}
+	return len(p), nil
+}
+
+func (w *HTTPBatchWriter) run() {
+	defer close(w.done)
+	var (
+		buf   bytes.Buffer
+		count int
+	)
+	flush := func() {
+		if count == 0 {
+			return
+		}
+		resp, err := w.client.Post(w.url, w.contentType, bytes.NewReader(buf.Bytes()))
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode >= 300 {
+				err = fmt.Errorf("status %v", resp.StatusCode)
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "发送 %v 条日志到 %v 失败: %v\n", count, w.url, err)
+		}
+		buf.Reset()
+		count = 0
+	}
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		select {
+		case line := <-w.lines:
+			buf.Write(line)
+			count++
+			if count >= w.size {
+				flush()
+			}
+		case <-t.C:
+			flush()
+		case <-w.stop:
+			for {
+				select {
+				case line := <-w.lines:
+					buf.Write(line)
+					count++
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close 发送缓冲区中剩余的日志
+func (w *HTTPBatchWriter) Close() error {
+	w.once.Do(func() { close(w.stop) })
+	<-w.done
+	return nil
+}
@@ -0,0 +1,27 @@
+package metrics
+
+// go-cqhttp 导出的指标
+var (
+	// APICalls API 调用次数
+	APICalls = NewCounterVec("cqhttp_api_calls_total", "API 调用次数", "action", "retcode")
+	// APIDuration API 调用耗时
+	APIDuration = NewHistogramVec("cqhttp_api_call_duration_seconds", "API 调用耗时", nil, "action")
+	// EventsDispatched 已分发的事件数
+	EventsDispatched = NewCounterVec("cqhttp_events_dispatched_total", "已分发的事件数", "post_type")
+	// EventHandlerDuration 各上报函数处理单个事件的耗时
+	EventHandlerDuration = NewHistogramVec("cqhttp_event_handler_duration_seconds", "上报函数处理事件的耗时", nil, "handler")
+	// HTTPPostRetries HTTP POST 上报重试次数
+	HTTPPostRetries = NewCounterVec("cqhttp_http_post_retries_total", "HTTP POST 上报重试次数", "url")
+	// HTTPPostFailures HTTP POST 上报最终失败或被丢弃的事件数
+	HTTPPostFailures = NewCounterVec("cqhttp_http_post_failures_total", "HTTP POST 上报失败的事件数", "url")
+	// WebSocketConnections 当前的 WebSocket 连接数
+	WebSocketConnections = NewGaugeVec("cqhttp_websocket_connections", "当前的 WebSocket 连接数", "type", "role")
+	// ReverseWebSocketDials 反向 WebSocket 连接尝试次数
+	ReverseWebSocketDials = NewCounterVec("cqhttp_reverse_websocket_dials_total", "反向 WebSocket 连接尝试次数", "role", "result")
+	// ReconnectAttempts 掉线后的重连尝试次数
+	ReconnectAttempts = NewCounterVec("cqhttp_reconnect_attempts_total", "掉线后的重连尝试次数", "result")
+	// SendQueueMessages 发送队列处理的消息数
+	SendQueueMessages = NewCounterVec("cqhttp_send_queue_messages_total", "发送队列处理的消息数", "result")
+	// SendRiskControlled 发送消息时检测到风控的次数
+	SendRiskControlled = NewCounterVec("cqhttp_send_risk_controlled_total", "发送消息时检测到风控的次数")
+)
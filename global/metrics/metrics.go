@@ -0,0 +1,122 @@
+// Package metrics 提供以 Prometheus 文本格式导出的运行指标
+package metrics
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+)
+
+// registry go-cqhttp 的指标, 同时包含 Go 运行时与进程的指标
+var registry = prometheus.NewRegistry()
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler 返回以 Prometheus 格式导出所有指标的 http.Handler
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// WriteText 以 Prometheus 文本格式写出所有指标
+func WriteText(w io.Writer) error {
+	families, err := registry.Gather()
+	if err != nil {
+		return err
+	}
+	for _, f := range families {
+		if _, err = expfmt.MetricFamilyToText(w, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CounterVec 带标签的计数器
+type CounterVec struct {
+	vec *prometheus.CounterVec
+}
+
+// NewCounterVec 创建并注册一个计数器
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{vec: prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)}
+	registry.MustRegister(c.vec)
+	return c
+}
+
+// Inc 计数加一
+func (c *CounterVec) Inc(lvs ...string) {
+	c.vec.WithLabelValues(lvs...).Inc()
+}
+
+// Add 计数增加 v
+func (c *CounterVec) Add(v float64, lvs ...string) {
+	c.vec.WithLabelValues(lvs...).Add(v)
+}
+
+// GaugeVec 带标签的仪表
+type GaugeVec struct {
+	vec *prometheus.GaugeVec
+}
+
+// NewGaugeVec 创建并注册一个仪表
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{vec: prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)}
+	registry.MustRegister(g.vec)
+	return g
+}
+
+// Inc 数值加一
+func (g *GaugeVec) Inc(lvs ...string) {
+	g.vec.WithLabelValues(lvs...).Inc()
+}
+
+// Dec 数值减一
+func (g *GaugeVec) Dec(lvs ...string) {
+	g.vec.WithLabelValues(lvs...).Dec()
+}
+
+// Add 数值增加 v
+func (g *GaugeVec) Add(v float64, lvs ...string) {
+	g.vec.WithLabelValues(lvs...).Add(v)
+}
+
+// Set 设置数值
+func (g *GaugeVec) Set(v float64, lvs ...string) {
+	g.vec.WithLabelValues(lvs...).Set(v)
+}
+
+// NewGaugeFunc 创建并注册一个在导出时调用 fn 获取数值的仪表
+func NewGaugeFunc(name, help string, fn func() float64) {
+	registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, fn))
+}
+
+// DefBuckets 默认的直方图分桶, 单位秒
+var DefBuckets = prometheus.DefBuckets
+
+// HistogramVec 带标签的直方图
+type HistogramVec struct {
+	vec *prometheus.HistogramVec
+}
+
+// NewHistogramVec 创建并注册一个直方图, buckets 为空时使用 DefBuckets
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	h := &HistogramVec{vec: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)}
+	registry.MustRegister(h.vec)
+	return h
+}
+
+// Observe 记录一个观测值
+func (h *HistogramVec) Observe(v float64, lvs ...string) {
+	h.vec.WithLabelValues(lvs...).Observe(v)
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteText(t *testing.T) {
+	c := NewCounterVec("test_calls_total", "test counter", "action")
+	c.Inc("get_status")
+	c.Add(2, "send_msg")
+	h := NewHistogramVec("test_duration_seconds", "test histogram", []float64{0.1, 1})
+	h.Observe(0.5)
+	NewGaugeFunc("test_size_bytes", "test gauge", func() float64 { return 42 })
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteText(&buf))
+	out := buf.String()
+	for _, line := range []string{
+		"# TYPE test_calls_total counter",
+		`test_calls_total{action="get_status"} 1`,
+		`test_calls_total{action="send_msg"} 2`,
+		`test_duration_seconds_bucket{le="0.1"} 0`,
+		`test_duration_seconds_bucket{le="1"} 1`,
+		`test_duration_seconds_bucket{le="+Inf"} 1`,
+		"test_duration_seconds_sum 0.5",
+		"test_duration_seconds_count 1",
+		"test_size_bytes 42",
+	} {
+		assert.True(t, strings.Contains(out, line+"\n"), line)
+	}
+}
@@ -16,8 +16,30 @@ var (
 	mainOnce   sync.Once
 
 	dumpMutex sync.Mutex
+
+	reloadHandler func()
+	reloadMutex   sync.Mutex
 )
 
+// OnReload 设置收到重载信号 (SIGHUP) 时调用的函数
+func OnReload(fn func()) {
+	reloadMutex.Lock()
+	reloadHandler = fn
+	reloadMutex.Unlock()
+}
+
+func reload() {
+	reloadMutex.Lock()
+	fn := reloadHandler
+	reloadMutex.Unlock()
+	if fn == nil {
+		log.Warn("收到重载信号, 但当前不支持重新加载配置.")
+		return
+	}
+	log.Info("收到重载信号, 开始重新加载配置文件.")
+	fn()
+}
+
 func dumpStack() {
 	dumpMutex.Lock()
 	defer dumpMutex.Unlock()
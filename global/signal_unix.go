@@ -15,7 +15,7 @@ func SetupMainSignalHandler() <-chan struct{} {
 		mainStopCh = make(chan struct{})
 		mc := make(chan os.Signal, 3)
 		closeOnce := sync.Once{}
-		signal.Notify(mc, os.Interrupt, syscall.SIGTERM, syscall.SIGUSR1)
+		signal.Notify(mc, os.Interrupt, syscall.SIGTERM, syscall.SIGUSR1, syscall.SIGHUP)
 		go func() {
 			for {
 				switch <-mc {
@@ -25,6 +25,8 @@ func SetupMainSignalHandler() <-chan struct{} {
 					})
 				case syscall.SIGUSR1:
 					dumpStack()
+				case syscall.SIGHUP:
+					go reload()
 				}
 			}
 		}()
@@ -20,6 +20,7 @@ import (
 var (
 	validTasks = map[string]func(){
 		"dumpstack": dumpStack,
+		"reload":    reload,
 	}
 )
 
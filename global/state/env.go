@@ -0,0 +1,64 @@
+package state
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"sync"
+)
+
+// envStore 从环境变量中读取 base64 编码的状态
+//
+// 环境变量无法在运行时持久化, 写入的内容仅在当前进程内有效.
+type envStore struct {
+	prefix string
+
+	lock    sync.RWMutex
+	overlay map[string][]byte
+}
+
+// NewEnvStore 创建一个从环境变量读取状态的存储后端
+//
+// 例: prefix 为 GCQ_STATE_ 时 session.token 对应环境变量 GCQ_STATE_SESSION_TOKEN
+func NewEnvStore(prefix string) Store {
+	if prefix == "" {
+		prefix = "GCQ_STATE_"
+	}
+	return &envStore{prefix: prefix, overlay: map[string][]byte{}}
+}
+
+// EnvName 返回 name 对应的环境变量名
+func (s *envStore) EnvName(name string) string {
+	return s.prefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(name))
+}
+
+func (s *envStore) Load(name string) ([]byte, error) {
+	s.lock.RLock()
+	data, ok := s.overlay[name]
+	s.lock.RUnlock()
+	if ok {
+		if data == nil {
+			return nil, ErrNotExist
+		}
+		return data, nil
+	}
+	v, ok := os.LookupEnv(s.EnvName(name))
+	if !ok || v == "" {
+		return nil, ErrNotExist
+	}
+	return base64.StdEncoding.DecodeString(v)
+}
+
+func (s *envStore) Save(name string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.overlay[name] = append([]byte{}, data...)
+	return nil
+}
+
+func (s *envStore) Delete(name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.overlay[name] = nil
+	return nil
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"os"
+	"path"
+)
+
+// fileStore 基于本地文件系统的存储后端
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore 创建一个保存在 dir 目录下的存储后端, dir 为空时使用工作目录
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+func (s *fileStore) Load(name string) ([]byte, error) {
+	data, err := os.ReadFile(path.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	}
+	return data, err
+}
+
+func (s *fileStore) Save(name string, data []byte) error {
+	if s.dir != "" {
+		if err := os.MkdirAll(s.dir, 0o755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path.Join(s.dir, name), data, 0o644)
+}
+
+func (s *fileStore) Delete(name string) error {
+	err := os.Remove(path.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+package state
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// s3Store 基于 S3 兼容对象存储 (AWS S3, MinIO 等) 的存储后端
+//
+// 使用 path-style 地址: <endpoint>/<bucket>/<prefix><name>, 请求使用 AWS Signature V4 签名.
+type s3Store struct {
+	endpoint  *url.URL
+	bucket    string
+	prefix    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+// NewS3Store 创建一个基于 S3 兼容对象存储的存储后端
+//
+// access-key 与 secret-key 未配置时将读取环境变量 AWS_ACCESS_KEY_ID 与 AWS_SECRET_ACCESS_KEY
+func NewS3Store(conf *config.S3StateConfig) (Store, error) {
+	if conf.Endpoint == "" || conf.Bucket == "" {
+		return nil, errors.New("s3 endpoint and bucket are required")
+	}
+	u, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse s3 endpoint error")
+	}
+	s := &s3Store{
+		endpoint:  u,
+		bucket:    conf.Bucket,
+		prefix:    conf.Prefix,
+		region:    conf.Region,
+		accessKey: conf.AccessKey,
+		secretKey: conf.SecretKey,
+		client:    &http.Client{Timeout: time.Second * 15},
+	}
+	if s.region == "" {
+		s.region = "us-east-1"
+	}
+	if s.accessKey == "" {
+		s.accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+	}
+	if s.secretKey == "" {
+		s.secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	return s, nil
+}
+
+func (s *s3Store) Load(name string) ([]byte, error) {
+	rsp, err := s.do(http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotExist
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("s3 get %v failed: %v", name, rsp.Status)
+	}
+	return io.ReadAll(rsp.Body)
+}
+
+func (s *s3Store) Save(name string, data []byte) error {
+	rsp, err := s.do(http.MethodPut, name, data)
+	if err != nil {
+		return err
+	}
+	_ = rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return errors.Errorf("s3 put %v failed: %v", name, rsp.Status)
+	}
+	return nil
+}
+
+func (s *s3Store) Delete(name string) error {
+	rsp, err := s.do(http.MethodDelete, name, nil)
+	if err != nil {
+		return err
+	}
+	_ = rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK && rsp.StatusCode != http.StatusNoContent && rsp.StatusCode != http.StatusNotFound {
+		return errors.Errorf("s3 delete %v failed: %v", name, rsp.Status)
+	}
+	return nil
+}
+
+func (s *s3Store) do(method, name string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + s.prefix + name
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body, time.Now().UTC())
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "s3 %v %v error", method, name)
+	}
+	return rsp, nil
+}
+
+// sign 使用 AWS Signature V4 签名请求
+func (s *s3Store) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256.Sum256(body)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	if s.accessKey == "" {
+		return // 匿名访问
+	}
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + hex.EncodeToString(payloadHash[:]),
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	crHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.region)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(crHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,45 @@
+// Package state 包含会话与设备信息等运行状态的存储后端
+package state
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// ErrNotExist 状态不存在时返回的错误
+var ErrNotExist = errors.New("state not exist")
+
+// Store 运行状态存储后端
+//
+// 用于保存 session.token, device.json, password.encrypt, address.txt 等文件,
+// 使其在无持久化存储的实例被回收后依然可用.
+type Store interface {
+	// Load 读取 name 对应的内容, 不存在时返回 ErrNotExist
+	Load(name string) ([]byte, error)
+	// Save 保存 name 对应的内容
+	Save(name string, data []byte) error
+	// Delete 删除 name 对应的内容, 不存在时不返回错误
+	Delete(name string) error
+}
+
+// New 根据配置创建存储后端, 未配置时使用工作目录
+func New(conf *config.StateConfig) (Store, error) {
+	switch strings.ToLower(conf.Type) {
+	case "", "file":
+		return NewFileStore(conf.Dir), nil
+	case "env":
+		return NewEnvStore(conf.EnvPrefix), nil
+	case "s3":
+		return NewS3Store(&conf.S3)
+	default:
+		return nil, errors.New("unsupported state store type: " + conf.Type)
+	}
+}
+
+// Exists 判断 name 是否存在于 s 中
+func Exists(s Store, name string) bool {
+	_, err := s.Load(name)
+	return err == nil
+}
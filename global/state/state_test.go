@@ -0,0 +1,95 @@
+package state
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// minioStandIn 一个简易的 S3 兼容对象存储, 仅用于测试
+func minioStandIn() *httptest.Server {
+	var (
+		lock    sync.Mutex
+		objects = map[string][]byte{}
+	)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = b
+		case http.MethodGet:
+			b, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(b)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func TestS3Store(t *testing.T) {
+	srv := minioStandIn()
+	defer srv.Close()
+
+	s, err := New(&config.StateConfig{Type: "s3", S3: config.S3StateConfig{
+		Endpoint:  srv.URL,
+		Bucket:    "gocq",
+		Prefix:    "bot1/",
+		AccessKey: "minio",
+		SecretKey: "minio123",
+	}})
+	assert.NoError(t, err)
+
+	_, err = s.Load("session.token")
+	assert.Equal(t, ErrNotExist, err)
+	assert.NoError(t, s.Save("session.token", []byte("token")))
+	data, err := s.Load("session.token")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("token"), data)
+	assert.NoError(t, s.Delete("session.token"))
+	assert.False(t, Exists(s, "session.token"))
+}
+
+func TestEnvStore(t *testing.T) {
+	_ = os.Setenv("GCQ_STATE_DEVICE_JSON", base64.StdEncoding.EncodeToString([]byte(`{}`)))
+	defer os.Unsetenv("GCQ_STATE_DEVICE_JSON")
+	s := NewEnvStore("")
+	data, err := s.Load("device.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{}`), data)
+
+	assert.NoError(t, s.Save("session.token", []byte("token")))
+	assert.True(t, Exists(s, "session.token"))
+	assert.NoError(t, s.Delete("device.json"))
+	assert.False(t, Exists(s, "device.json"))
+}
+
+func TestFileStore(t *testing.T) {
+	s := NewFileStore(t.TempDir())
+	assert.False(t, Exists(s, "address.txt"))
+	assert.NoError(t, s.Save("address.txt", []byte("127.0.0.1:8080")))
+	data, err := s.Load("address.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("127.0.0.1:8080"), data)
+	assert.NoError(t, s.Delete("address.txt"))
+	assert.NoError(t, s.Delete("address.txt"))
+}
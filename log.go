@@ -0,0 +1,169 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	rotatelogs "github.com/lestrrat-go/file-rotatelogs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+var (
+	logLock sync.Mutex
+
+	// logClosers 需要在重新加载配置或退出时关闭的日志输出
+	logClosers []io.Closer
+
+	// rotateWriters 按目录缓存的日志文件, 重新加载配置时复用, 以免 log-force-new 时创建新的文件
+	rotateWriters = make(map[string]*rotatelogs.RotateLogs)
+)
+
+// newRotateWriter 返回 dir 下按天切分的日志文件, 目录无法创建时返回错误
+func newRotateWriter(conf *config.Config, dir string) (io.Writer, error) {
+	if w, ok := rotateWriters[dir]; ok {
+		return w, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "create log dir error")
+	}
+	rotateOptions := []rotatelogs.Option{
+		rotatelogs.WithRotationTime(time.Hour * 24),
+	}
+	if conf.Output.LogAging > 0 {
+		rotateOptions = append(rotateOptions, rotatelogs.WithMaxAge(time.Hour*24*time.Duration(conf.Output.LogAging)))
+	}
+	if conf.Output.LogForceNew {
+		rotateOptions = append(rotateOptions, rotatelogs.ForceNewFile())
+	}
+	w, err := rotatelogs.New(path.Join(dir, "%Y-%m-%d.log"), rotateOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "rotatelogs init error")
+	}
+	rotateWriters[dir] = w
+	return w, nil
+}
+
+// newLogSink 按 sink 的配置创建日志钩子, 未设置的等级与格式使用 output 中的配置
+func newLogSink(conf *config.Config, sink *config.LogSink) (*global.LocalHook, io.Closer, error) {
+	level, format := sink.Level, sink.Format
+	if level == "" {
+		level = conf.Output.LogLevel
+	}
+	if format == "" {
+		format = conf.Output.Format
+	}
+	formatter := global.NewLogFormatter(format)
+	var (
+		w      io.Writer
+		closer io.Closer
+		err    error
+	)
+	switch sink.Type {
+	case "stdout":
+		w = os.Stdout
+	case "file":
+		dir := sink.Dir
+		if dir == "" {
+			dir = global.LogPath
+		}
+		if w, err = newRotateWriter(conf, dir); err != nil {
+			return nil, nil, err
+		}
+	case "syslog":
+		if sink.Address == "" {
+			return nil, nil, errors.New("address is empty")
+		}
+		network := sink.Network
+		if network == "" {
+			network = "udp"
+		}
+		nw := global.NewNetWriter(network, sink.Address)
+		w, closer = nw, nw
+		formatter = global.NewSyslogFormatter(formatter, sink.Tag)
+	case "http":
+		if sink.URL == "" {
+			return nil, nil, errors.New("url is empty")
+		}
+		contentType := "text/plain; charset=utf-8"
+		if format == "json" {
+			contentType = "application/x-ndjson"
+		}
+		hw := global.NewHTTPBatchWriter(sink.URL, contentType, sink.BatchSize, time.Second*time.Duration(sink.FlushInterval))
+		w, closer = hw, hw
+	default:
+		return nil, nil, errors.Errorf("unsupported sink type: %v", sink.Type)
+	}
+	return global.NewLocalHook(w, formatter, global.GetLogLevel(level)...), closer, nil
+}
+
+// applyLogConfig 应用日志相关的配置, 按 output.sinks 重建所有日志输出
+//
+// 未配置 sinks 时日志写入 logs 目录并输出到标准错误;
+// 配置 sinks 后仅输出到 sinks 中, 初始化失败的输出将被跳过.
+func applyLogConfig(conf *config.Config) {
+	logLock.Lock()
+	defer logLock.Unlock()
+	sinks := conf.Output.Sinks
+	if len(sinks) == 0 {
+		sinks = []config.LogSink{{Type: "file"}}
+	}
+	var (
+		hooks   []*global.LocalHook
+		closers []io.Closer
+		level   = log.InfoLevel
+	)
+	for i := range sinks {
+		hook, closer, err := newLogSink(conf, &sinks[i])
+		if err != nil {
+			log.Warnf("日志输出 %v 初始化失败, 已跳过: %v", sinks[i].Type, err)
+			continue
+		}
+		hooks = append(hooks, hook)
+		if closer != nil {
+			closers = append(closers, closer)
+		}
+		for _, l := range hook.Levels() {
+			if l > level {
+				level = l
+			}
+		}
+	}
+	if len(conf.Output.Sinks) > 0 && len(hooks) > 0 {
+		log.SetOutput(io.Discard)
+	} else {
+		// 未配置 sinks 或所有输出均初始化失败时保持原有的标准错误输出
+		log.SetOutput(os.Stderr)
+		level = log.InfoLevel
+	}
+	if conf.Output.Debug && level < log.DebugLevel {
+		level = log.DebugLevel
+	}
+	log.SetLevel(level)
+	log.SetReportCaller(conf.Output.Debug)
+	log.SetFormatter(global.NewLogFormatter(conf.Output.Format))
+	log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+	for _, hook := range hooks {
+		log.AddHook(hook)
+	}
+	for _, c := range logClosers {
+		_ = c.Close()
+	}
+	logClosers = closers
+}
+
+// closeLogSinks 关闭网络日志输出, 发送缓冲区中剩余的日志
+func closeLogSinks() {
+	logLock.Lock()
+	defer logLock.Unlock()
+	for _, c := range logClosers {
+		_ = c.Close()
+	}
+	logClosers = nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/tuotoo/qrcode"
 
+	"github.com/Mrs4s/go-cqhttp/coolq"
 	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/server"
 )
 
 var console = bufio.NewReader(os.Stdin)
@@ -43,6 +46,9 @@ var readLineTimeout = func(t time.Duration, de string) (str string) {
 
 var cli *client.QQClient
 
+// loginBroker 非交互式登录验证代理, 为 nil 时使用标准输入
+var loginBroker *server.LoginBroker
+
 // ErrSMSRequestError SMS请求出错
 var ErrSMSRequestError = errors.New("sms request error")
 
@@ -63,8 +69,15 @@ func qrcodeLogin() error {
 	if err != nil {
 		return err
 	}
-	_ = ioutil.WriteFile("qrcode.png", rsp.ImageData, 0o644)
-	defer func() { _ = os.Remove("qrcode.png") }()
+	if loginBroker != nil {
+		loginBroker.Notify("qrcode", coolq.MSG{
+			"image": base64.StdEncoding.EncodeToString(rsp.ImageData),
+			"url":   fi.Content,
+		})
+	} else {
+		_ = ioutil.WriteFile("qrcode.png", rsp.ImageData, 0o644)
+		defer func() { _ = os.Remove("qrcode.png") }()
+	}
 	if cli.Uin != 0 {
 		log.Infof("请使用账号 %v 登录手机QQ扫描二维码 (qrcode.png) : ", cli.Uin)
 	} else {
@@ -118,6 +131,18 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 		var text string
 		switch res.Error {
 		case client.SliderNeededError:
+			if loginBroker != nil {
+				text, err = loginBroker.Challenge("slider", coolq.MSG{"url": res.VerifyUrl})
+				if err == nil {
+					res, err = cli.SubmitTicket(text)
+					continue
+				}
+				log.Warnf("等待滑条验证结果失败: %v, 将使用二维码登录.", err)
+				cli.Disconnect()
+				cli.Release()
+				cli = client.NewClientEmpty()
+				return qrcodeLogin()
+			}
 			log.Warnf("登录需要滑条验证码. ")
 			log.Warnf("请参考文档 -> https://docs.go-cqhttp.org/faq/slider.html <- 进行处理")
 			log.Warnf("1. 自行抓包并获取 Ticket 输入.")
@@ -139,6 +164,16 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 			return qrcodeLogin()
 		case client.NeedCaptcha:
 			log.Warnf("登录需要验证码.")
+			if loginBroker != nil {
+				text, err = loginBroker.Challenge("captcha", coolq.MSG{
+					"image": base64.StdEncoding.EncodeToString(res.CaptchaImage),
+				})
+				if err != nil {
+					return err
+				}
+				res, err = cli.SubmitCaptcha(text, res.CaptchaSign)
+				continue
+			}
 			_ = ioutil.WriteFile("captcha.jpg", res.CaptchaImage, 0o644)
 			log.Warnf("请输入验证码 (captcha.jpg)： (Enter 提交)")
 			text = readLine()
@@ -146,6 +181,10 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 			res, err = cli.SubmitCaptcha(text, res.CaptchaSign)
 			continue
 		case client.SMSNeededError:
+			if loginBroker != nil {
+				res, err = brokerSMSLogin(res.SMSPhone)
+				continue
+			}
 			log.Warnf("账号已开启设备锁, 按 Enter 向手机 %v 发送短信验证码.", res.SMSPhone)
 			readLine()
 			if !cli.RequestSMS() {
@@ -157,6 +196,10 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 			res, err = cli.SubmitSMS(text)
 			continue
 		case client.SMSOrVerifyNeededError:
+			if loginBroker != nil {
+				res, err = brokerSMSLogin(res.SMSPhone)
+				continue
+			}
 			log.Warnf("账号已开启设备锁，请选择验证方式:")
 			log.Warnf("1. 向手机 %v 发送短信验证码", res.SMSPhone)
 			log.Warnf("2. 使用手机QQ扫码验证.")
@@ -175,6 +218,9 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 			fallthrough
 		case client.UnsafeDeviceError:
 			log.Warnf("账号已开启设备锁，请前往 -> %v <- 验证后重启Bot.", res.VerifyUrl)
+			if loginBroker != nil {
+				loginBroker.Notify("device_lock", coolq.MSG{"url": res.VerifyUrl})
+			}
 			log.Infof("按 Enter 或等待 5s 后继续....")
 			readLineTimeout(time.Second*5, "")
 			os.Exit(0)
@@ -193,3 +239,16 @@ func loginResponseProcessor(res *client.LoginResponse) error {
 		}
 	}
 }
+
+// brokerSMSLogin 通过登录验证代理获取短信验证码并提交
+func brokerSMSLogin(phone string) (*client.LoginResponse, error) {
+	if !cli.RequestSMS() {
+		log.Warnf("发送验证码失败，可能是请求过于频繁.")
+		return nil, errors.WithStack(ErrSMSRequestError)
+	}
+	text, err := loginBroker.Challenge("sms", coolq.MSG{"phone": phone})
+	if err != nil {
+		return nil, err
+	}
+	return cli.SubmitSMS(text)
+}
@@ -3,15 +3,12 @@ package main
 import (
 	"bufio"
 	"crypto/aes"
-	"crypto/md5"
 	"crypto/sha1"
 	"encoding/hex"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"os/exec"
-	"path"
 	"runtime"
 	"strings"
 	"sync"
@@ -24,30 +21,35 @@ import (
 	"github.com/Mrs4s/go-cqhttp/global/update"
 	"github.com/Mrs4s/go-cqhttp/server"
 
-	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/client"
 	"github.com/guonaihong/gout"
-	rotatelogs "github.com/lestrrat-go/file-rotatelogs"
 	log "github.com/sirupsen/logrus"
-	easy "github.com/t-tomalak/logrus-easy-formatter"
 	"github.com/tidwall/gjson"
 	"golang.org/x/crypto/pbkdf2"
-	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
 )
 
 var (
+	// conf 当前的配置, 启动完成后可能被 reloadConfig 在其他协程中替换, 此后需通过 currentConfig 读取
 	conf        *config.Config
+	confLock    sync.RWMutex
+	reloadLock  sync.Mutex // 保证同一时间只有一次重新加载
 	isFastStart = false
 	c           string
 	d           bool
 	h           bool
 	wd          string // reset work dir
+	debug       bool
 
-	// PasswordHash 存储QQ密码哈希供登录使用
-	PasswordHash [16]byte
+	// servers 根据全局 servers 配置启动的通信服务, 多账号模式下服务于所有账号
+	servers *server.Manager
 
-	// AccountToken 存储AccountToken供登录使用
-	AccountToken []byte
+	// accounts 已登录的账号, 停止的账号将被移除, 需通过 runningAccounts 读取
+	accounts     []*account
+	accountsLock sync.Mutex
+
+	// byteKey 密码加密使用的 Key, 多个账号共用
+	byteKey []byte
 
 	// 允许通过配置文件设置的状态列表
 	allowStatus = [...]client.UserOnlineStatus{
@@ -60,7 +62,6 @@ var (
 )
 
 func init() {
-	var debug bool
 	flag.StringVar(&c, "c", config.DefaultConfigFile, "configuration filename default is config.hjson")
 	flag.BoolVar(&d, "d", false, "running as a daemon")
 	flag.BoolVar(&debug, "D", false, "debug mode")
@@ -70,38 +71,13 @@ func init() {
 
 	// 通过-c 参数替换 配置文件路径
 	config.DefaultConfigFile = c
-	logFormatter := &easy.Formatter{
-		TimestampFormat: "2006-01-02 15:04:05",
-		LogFormat:       "[%time%] [%lvl%]: %msg% \n",
-	}
-
 	conf = config.Get()
-
-	rotateOptions := []rotatelogs.Option{
-		rotatelogs.WithRotationTime(time.Hour * 24),
-	}
-
-	if conf.Output.LogAging > 0 {
-		rotateOptions = append(rotateOptions, rotatelogs.WithMaxAge(time.Hour*24*time.Duration(conf.Output.LogAging)))
-	}
-	if conf.Output.LogForceNew {
-		rotateOptions = append(rotateOptions, rotatelogs.ForceNewFile())
-	}
-
-	w, err := rotatelogs.New(path.Join("/mnt/logs", "%Y-%m-%d.log"), rotateOptions...)
-	if err != nil {
-		log.Errorf("rotatelogs init err: %v", err)
-		panic(err)
-	}
+	global.SetStorageRoot(conf.Storage.Root)
 
 	if debug {
 		conf.Output.Debug = true
 	}
-	// 在debug模式下,将在标准输出中打印当前执行行数
-	if conf.Output.Debug {
-		log.SetReportCaller(true)
-	}
-	log.AddHook(global.NewLocalHook(w, logFormatter, global.GetLogLevel(conf.Output.LogLevel)...))
+	applyLogConfig(conf)
 
 	if !global.PathExists(global.ImagePath) {
 		if err := os.MkdirAll(global.ImagePath, 0o755); err != nil {
@@ -135,7 +111,6 @@ func main() {
 	if wd != "" {
 		resetWorkDir()
 	}
-	var byteKey []byte
 	arg := os.Args
 	if len(arg) > 1 {
 		for i := range arg {
@@ -155,299 +130,245 @@ func main() {
 			}
 		}
 	}
+	serverless := findServerlessConfig()
+	if serverless != nil {
+		// Serverless 实例无法进行任何交互, 跳过所有等待
+		isFastStart = true
+	}
 	if terminal.RunningByDoubleClick() && !isFastStart {
 		log.Warning("警告: 强烈不推荐通过双击直接运行本程序, 这将导致一些非预料的后果.")
 		log.Warning("将等待10s后启动")
 		time.Sleep(time.Second * 10)
 	}
 
-	if (conf.Account.Uin == 0 || (conf.Account.Password == "" && !conf.Account.Encrypt)) && !global.PathExists("session.token") {
-		log.Warn("账号密码未配置, 将使用二维码登录.")
-		if !isFastStart {
-			log.Warn("将在 5秒 后继续.")
-			time.Sleep(time.Second * 5)
-		}
-	}
-
 	log.Info("当前版本:", coolq.Version)
 	if conf.Output.Debug {
-		log.SetLevel(log.DebugLevel)
 		log.Warnf("已开启Debug模式.")
 		log.Debugf("开发交流群: 192548878")
 	}
 	log.Info("用户交流群: 721829413")
-	if !global.PathExists("device.json") {
-		log.Warn("虚拟设备信息不存在, 将自动生成随机设备.")
-		client.GenRandomDevice()
-		_ = ioutil.WriteFile("device.json", client.SystemDeviceInfo.ToJson(), 0o644)
-		log.Info("已生成设备信息并保存到 device.json 文件.")
-	} else {
-		log.Info("将使用 device.json 内的设备信息运行Bot.")
-		if err := client.SystemDeviceInfo.ReadJson([]byte(global.ReadAllText("device.json"))); err != nil {
-			log.Fatalf("加载设备信息失败: %v", err)
-		}
-	}
-
-	if conf.Account.Encrypt {
-		if !global.PathExists("password.encrypt") {
-			if conf.Account.Password == "" {
-				log.Error("无法进行加密，请在配置文件中的添加密码后重新启动.")
-				readLine()
-				os.Exit(0)
-			}
-			log.Infof("密码加密已启用, 请输入Key对密码进行加密: (Enter 提交)")
-			byteKey, _ = term.ReadPassword(int(os.Stdin.Fd()))
-			PasswordHash = md5.Sum([]byte(conf.Account.Password))
-			_ = os.WriteFile("password.encrypt", []byte(PasswordHashEncrypt(PasswordHash[:], byteKey)), 0o644)
-			log.Info("密码已加密，为了您的账号安全，请删除配置文件中的密码后重新启动.")
-			readLine()
-			os.Exit(0)
-		} else {
-			if conf.Account.Password != "" {
-				log.Error("密码已加密，为了您的账号安全，请删除配置文件中的密码后重新启动.")
-				readLine()
-				os.Exit(0)
-			}
-
-			if len(byteKey) == 0 {
-				log.Infof("密码加密已启用, 请输入Key对密码进行解密以继续: (Enter 提交)")
-				cancel := make(chan struct{}, 1)
-				state, _ := term.GetState(int(os.Stdin.Fd()))
-				go func() {
-					select {
-					case <-cancel:
-						return
-					case <-time.After(time.Second * 45):
-						log.Infof("解密key输入超时")
-						time.Sleep(3 * time.Second)
-						_ = term.Restore(int(os.Stdin.Fd()), state)
-						os.Exit(0)
-					}
-				}()
-				byteKey, _ = term.ReadPassword(int(os.Stdin.Fd()))
-				cancel <- struct{}{}
-			} else {
-				log.Infof("密码加密已启用, 使用运行时传递的参数进行解密，按 Ctrl+C 取消.")
-			}
-
-			encrypt, _ := os.ReadFile("password.encrypt")
-			ph, err := PasswordHashDecrypt(string(encrypt), byteKey)
-			if err != nil {
-				log.Fatalf("加密存储的密码损坏，请尝试重新配置密码")
-			}
-			copy(PasswordHash[:], ph)
-		}
-	} else {
-		PasswordHash = md5.Sum([]byte(conf.Account.Password))
+	accounts = loadAccounts(conf)
+	for _, a := range accounts {
+		a.prepare(serverless)
 	}
 	if !isFastStart {
 		log.Info("Bot将在5秒后登录并开始信息处理, 按 Ctrl+C 取消.")
 		time.Sleep(time.Second * 5)
 	}
-	log.Info("开始尝试登录并同步消息...")
-	log.Infof("使用协议: %v", func() string {
-		switch client.SystemDeviceInfo.Protocol {
-		case client.IPad:
-			return "iPad"
-		case client.AndroidPhone:
-			return "Android Phone"
-		case client.AndroidWatch:
-			return "Android Watch"
-		case client.MacOS:
-			return "MacOS"
-		case client.QiDian:
-			return "企点"
-		}
-		return "未知"
-	}())
-	cli = client.NewClientEmpty()
-	if conf.Account.Uin != 0 && PasswordHash != [16]byte{} {
-		cli.Uin = conf.Account.Uin
-		cli.PasswordMd5 = PasswordHash
-	}
-	cli.OnLog(func(c *client.QQClient, e *client.LogEvent) {
-		switch e.Type {
-		case "INFO":
-			log.Info("Protocol -> " + e.Message)
-		case "ERROR":
-			log.Error("Protocol -> " + e.Message)
-		case "DEBUG":
-			log.Debug("Protocol -> " + e.Message)
-		}
-	})
-	if global.PathExists("address.txt") {
-		log.Infof("检测到 address.txt 文件. 将覆盖目标IP.")
-		addr := global.ReadAddrFile("address.txt")
-		if len(addr) > 0 {
-			cli.SetCustomServer(addr)
+	global.Proxy = conf.Message.ProxyRewrite
+	for _, a := range accounts {
+		log.Infof("开始尝试登录账号 %v 并同步消息...", a.conf.Account.Uin)
+		a.login(serverless)
+	}
+	log.Info("正在加载事件过滤器.")
+	applyMessageConfig(conf)
+	server.SetAsyncConfig(&conf.Async)
+	if len(conf.Accounts) == 0 {
+		servers = server.NewManager(accounts[0].bot)
+	} else {
+		servers = server.NewManager(nil)
+		for _, a := range runningAccounts() {
+			a.servers = server.NewManager(a.bot)
+			a.servers.Apply(a.conf.Servers)
 		}
-		log.Infof("读取到 %v 个自定义地址.", len(addr))
 	}
-	cli.OnServerUpdated(func(bot *client.QQClient, e *client.ServerUpdatedEvent) bool {
-		if !conf.Account.UseSSOAddress {
-			log.Infof("收到服务器地址更新通知, 根据配置文件已忽略.")
-			return false
+	servers.Apply(conf.Servers)
+	server.ReloadConfig = reloadConfig
+	server.RestartServers = restartServers
+	server.ExitProcess = exitProcess
+	global.OnReload(func() {
+		if err := reloadConfig(); err != nil {
+			log.Warnf("重新加载配置文件失败: %v", err)
 		}
-		log.Infof("收到服务器地址更新通知, 将在下一次重连时应用. ")
-		return true
 	})
-	global.Proxy = conf.Message.ProxyRewrite
-	isQRCodeLogin := (conf.Account.Uin == 0 || len(conf.Account.Password) == 0) && !conf.Account.Encrypt
-	isTokenLogin := false
-	saveToken := func() {
-		AccountToken = cli.GenToken()
-		_ = ioutil.WriteFile("session.token", AccountToken, 0o677)
-	}
-	if global.PathExists("session.token") {
-		token, err := ioutil.ReadFile("session.token")
-		if err == nil {
-			if conf.Account.Uin != 0 {
-				r := binary.NewReader(token)
-				cu := r.ReadInt64()
-				if cu != conf.Account.Uin {
-					log.Warnf("警告: 配置文件内的QQ号 (%v) 与缓存内的QQ号 (%v) 不相同", conf.Account.Uin, cu)
-					log.Warnf("1. 使用会话缓存继续.")
-					log.Warnf("2. 删除会话缓存并重启.")
-					log.Warnf("请选择: (5秒后自动选1)")
-					text := readLineTimeout(time.Second*5, "1")
-					if text == "2" {
-						_ = os.Remove("session.token")
-						os.Exit(0)
-					}
-				}
-			}
-			if err = cli.TokenLogin(token); err != nil {
-				_ = os.Remove("session.token")
-				log.Warnf("恢复会话失败: %v , 尝试使用正常流程登录.", err)
-				time.Sleep(time.Second)
-			} else {
-				isTokenLogin = true
-			}
-		}
+	log.Info("资源初始化完成, 开始处理信息.")
+	log.Info("アトリは、高性能ですから!")
+
+	if serverless == nil {
+		go checkUpdate()
 	}
-	if !isTokenLogin {
-		if !isQRCodeLogin {
-			if err := commonLogin(); err != nil {
-				log.Fatalf("登录时发生致命错误: %v", err)
-			}
-		} else {
-			if err := qrcodeLogin(); err != nil {
-				log.Fatalf("登录时发生致命错误: %v", err)
-			}
+
+	<-global.SetupMainSignalHandler()
+	shutdown()
+}
+
+// shutdown 按顺序退出: 拒绝新的 API 调用, 推送 lifecycle/disable 事件,
+// 等待正在处理的 API 调用与事件上报完成, 关闭通信服务与数据库
+func shutdown() {
+	timeout := time.Second * time.Duration(currentConfig().Shutdown.Timeout)
+	if timeout <= 0 {
+		timeout = time.Second * 10
+	}
+	log.Infof("正在退出, 最多等待 %v.", timeout)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		deadline := time.Now().Add(timeout)
+		if !server.StopAcceptingAPI(time.Until(deadline)) {
+			log.Warn("等待 API 调用完成超时.")
 		}
-	}
-	var times uint = 1 // 重试次数
-	var reLoginLock sync.Mutex
-	cli.OnDisconnected(func(q *client.QQClient, e *client.ClientDisconnectedEvent) {
-		reLoginLock.Lock()
-		defer reLoginLock.Unlock()
-		times = 1
-		if cli.Online {
-			return
+		running := runningAccounts()
+		for _, a := range running {
+			a.bot.PushLifecycle("disable")
 		}
-		log.Warnf("Bot已离线: %v", e.Message)
-		time.Sleep(time.Second * time.Duration(conf.Account.ReLogin.Delay))
-		for {
-			if conf.Account.ReLogin.Disabled {
-				os.Exit(1)
-			}
-			if times > conf.Account.ReLogin.MaxTimes && conf.Account.ReLogin.MaxTimes != 0 {
-				log.Fatalf("Bot重连次数超过限制, 停止")
-			}
-			times++
-			if conf.Account.ReLogin.Interval > 0 {
-				log.Warnf("将在 %v 秒后尝试重连. 重连次数：%v/%v", conf.Account.ReLogin.Interval, times, conf.Account.ReLogin.MaxTimes)
-				time.Sleep(time.Second * time.Duration(conf.Account.ReLogin.Interval))
-			} else {
-				time.Sleep(time.Second)
-			}
-			log.Warnf("尝试重连...")
-			err := cli.TokenLogin(AccountToken)
-			if err == nil {
-				saveToken()
-				return
-			}
-			log.Warnf("快速重连失败: %v", err)
-			if isQRCodeLogin {
-				log.Fatalf("快速重连失败, 扫码登录无法恢复会话.")
-			}
-			log.Warnf("快速重连失败, 尝试普通登录. 这可能是因为其他端强行T下线导致的.")
-			time.Sleep(time.Second)
-			if err := commonLogin(); err != nil {
-				log.Errorf("登录时发生致命错误: %v", err)
-			} else {
-				saveToken()
+		for _, a := range running {
+			if !a.bot.FlushEvents(time.Until(deadline)) {
+				log.Warn("等待事件上报完成超时.")
 				break
 			}
 		}
-	})
-	saveToken()
-	cli.AllowSlider = true
-	log.Infof("登录成功 欢迎使用: %v", cli.Nickname)
-	log.Info("开始加载好友列表...")
-	global.Check(cli.ReloadFriendList(), true)
-	log.Infof("共加载 %v 个好友.", len(cli.FriendList))
-	log.Infof("开始加载群列表...")
-	global.Check(cli.ReloadGroupList(), true)
-	log.Infof("共加载 %v 个群.", len(cli.GroupList))
-	if conf.Account.Status >= int32(len(allowStatus)) || conf.Account.Status < 0 {
-		conf.Account.Status = 0
-	}
-	cli.SetOnlineStatus(allowStatus[int(conf.Account.Status)])
-	bot := coolq.NewQQBot(cli, conf)
-	_ = bot.Client
+		servers.Close()
+		for _, a := range running {
+			if a.servers != nil {
+				a.servers.Close()
+			}
+			a.bot.Release()
+		}
+	}()
+	select {
+	case <-done:
+		log.Info("已退出.")
+	case <-time.After(timeout):
+		log.Warn("退出超时, 将强制退出.")
+	}
+	closeLogSinks()
+}
+
+// exitProcess 完成退出流程后结束进程, 用于管理 API 的冷重启
+func exitProcess(restartProcess bool) {
+	shutdown()
+	if restartProcess {
+		if err := restart(); err != nil {
+			log.Errorf("重启失败: %v", err)
+			os.Exit(1)
+		}
+	}
+	os.Exit(0)
+}
+
+// applyMessageConfig 应用消息相关的配置
+func applyMessageConfig(conf *config.Config) {
 	if conf.Message.PostFormat != "string" && conf.Message.PostFormat != "array" {
 		log.Warnf("post-format 配置错误, 将自动使用 string")
 		coolq.SetMessageFormat("string")
 	} else {
 		coolq.SetMessageFormat(conf.Message.PostFormat)
 	}
-	log.Info("正在加载事件过滤器.")
 	coolq.IgnoreInvalidCQCode = conf.Message.IgnoreInvalidCQCode
 	coolq.SplitURL = conf.Message.FixURL
 	coolq.ForceFragmented = conf.Message.ForceFragment
 	coolq.RemoveReplyAt = conf.Message.RemoveReplyAt
 	coolq.ExtraReplyData = conf.Message.ExtraReplyData
-	for _, m := range conf.Servers {
-		if h, ok := m["http"]; ok {
-			hc := new(config.HTTPServer)
-			if err := h.Decode(hc); err != nil {
-				log.Warn("读取http配置失败 :", err)
-			} else {
-				go server.RunHTTPServerAndClients(bot, hc)
-			}
+	global.Proxy = conf.Message.ProxyRewrite
+}
+
+// reloadConfig 重新读取配置文件并应用, 登录会话不受影响
+//
+// 配置文件不合法时返回错误, 通信服务将在后台按差异重启,
+// 以免通过 reload_config API 调用时等待自身所在的服务器关闭.
+func reloadConfig() error {
+	return applyConfig(false, 0)
+}
+
+// restartServers 重新读取配置文件, 并在 delay 后停止并重新启动所有通信服务, 用于管理 API 的热重启
+func restartServers(delay time.Duration) error {
+	return applyConfig(true, delay)
+}
+
+// applyConfig 重新读取配置文件并应用, restart 为 true 时在 delay 后重启所有通信服务
+func applyConfig(restart bool, delay time.Duration) error {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+	c, err := config.Reload()
+	if err != nil {
+		return err
+	}
+	old := currentConfig()
+	if c.Account.Uin != old.Account.Uin || len(c.Accounts) != len(old.Accounts) {
+		log.Warnf("账号配置的修改需要重启后生效.")
+	}
+	// 多账号模式下按 uin 找到账号对应的新配置, 仅重新应用其通信服务
+	running := runningAccounts()
+	next := make(map[*account][]map[string]yaml.Node)
+	for _, a := range running {
+		if a.servers == nil {
+			continue
 		}
-		if s, ok := m["ws"]; ok {
-			sc := new(config.WebsocketServer)
-			if err := s.Decode(sc); err != nil {
-				log.Warn("读取正向Websocket配置失败 :", err)
-			} else {
-				go server.RunWebSocketServer(bot, sc)
+		next[a] = a.conf.Servers
+		for i := range c.Accounts {
+			if c.Accounts[i].Uin == a.conf.Account.Uin {
+				next[a] = c.Accounts[i].Servers
+				break
 			}
 		}
-		if c, ok := m["ws-reverse"]; ok {
-			rc := new(config.WebsocketReverse)
-			if err := c.Decode(rc); err != nil {
-				log.Warn("读取反向Websocket配置失败 :", err)
-			} else {
-				go server.RunWebSocketClient(bot, rc)
+	}
+	c.Output.Debug = c.Output.Debug || debug
+	confLock.Lock()
+	conf = c
+	confLock.Unlock()
+	applyLogConfig(c)
+	applyMessageConfig(c)
+	for _, a := range running {
+		a.bot.SetSendQueueConfig(&c.Message.SendQueue)
+	}
+	server.SetAsyncConfig(&c.Async)
+	server.ReloadFilters()
+	go func() {
+		if restart {
+			time.Sleep(delay)
+			servers.Close()
+			for a := range next {
+				a.servers.Close()
 			}
 		}
-		if p, ok := m["pprof"]; ok {
-			pc := new(config.PprofServer)
-			if err := p.Decode(pc); err != nil {
-				log.Warn("读取pprof配置失败 :", err)
-			} else {
-				go server.RunPprofServer(pc)
-			}
+		servers.Apply(c.Servers)
+		for a, list := range next {
+			a.servers.Apply(list)
+		}
+		log.Info("配置文件已重新加载.")
+	}()
+	return nil
+}
+
+// runningAccounts 返回未停止的账号
+func runningAccounts() []*account {
+	accountsLock.Lock()
+	defer accountsLock.Unlock()
+	return append([]*account(nil), accounts...)
+}
+
+// removeAccount 移除已停止的账号, 返回剩余的账号数
+func removeAccount(a *account) int {
+	accountsLock.Lock()
+	defer accountsLock.Unlock()
+	rest := make([]*account, 0, len(accounts))
+	for _, other := range accounts {
+		if other != a {
+			rest = append(rest, other)
 		}
 	}
-	log.Info("资源初始化完成, 开始处理信息.")
-	log.Info("アトリは、高性能ですから!")
+	accounts = rest
+	return len(accounts)
+}
 
-	go checkUpdate()
+// currentConfig 返回当前的配置
+func currentConfig() *config.Config {
+	confLock.RLock()
+	defer confLock.RUnlock()
+	return conf
+}
 
-	<-global.SetupMainSignalHandler()
+// findServerlessConfig 返回第一个启用的 Serverless 配置, 未启用时返回 nil
+func findServerlessConfig() *config.ServerlessServer {
+	for _, m := range conf.Servers {
+		if sl, ok := m["serverless"]; ok {
+			slc := new(config.ServerlessServer)
+			if err := sl.Decode(slc); err == nil && !slc.Disabled {
+				return slc
+			}
+		}
+	}
+	return nil
 }
 
 // PasswordHashEncrypt 使用key加密给定passwordHash
@@ -578,38 +499,29 @@ wait:
 	os.Exit(0)
 }
 
-/*
-func restart(args []string) {
+// restart 以相同的参数启动一个新的进程, 新进程将跳过启动时的等待
+func restart() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := os.Args[1:]
+	fast := false
+	for _, arg := range args {
+		fast = fast || arg == "faststart"
+	}
+	if !fast {
+		args = append(args, "faststart")
+	}
 	var cmd *exec.Cmd
 	if runtime.GOOS == "windows" {
-		file, err := exec.LookPath(args[0])
-		if err != nil {
-			log.Errorf("重启失败:%s", err.Error())
-			return
-		}
-		path, err := filepath.Abs(file)
-		if err != nil {
-			log.Errorf("重启失败:%s", err.Error())
-		}
-		args = append([]string{"/c", "start ", path, "faststart"}, args[1:]...)
-		cmd = &exec.Cmd{
-			Path:   "cmd.exe",
-			Args:   args,
-			Stderr: os.Stderr,
-			Stdout: os.Stdout,
-		}
+		cmd = exec.Command("cmd.exe", append([]string{"/c", "start", "", exe}, args...)...)
 	} else {
-		args = append(args, "faststart")
-		cmd = &exec.Cmd{
-			Path:   args[0],
-			Args:   args,
-			Stderr: os.Stderr,
-			Stdout: os.Stdout,
-		}
+		cmd = exec.Command(exe, args...)
 	}
-	_ = cmd.Start()
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Start()
 }
-*/
 
 // help cli命令行-h的帮助提示
 func help() {
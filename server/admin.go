@@ -0,0 +1,513 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// ExitProcess 在完成退出流程后结束进程, restart 为 true 时先启动一个新的进程, 由 main 设置
+var ExitProcess func(restart bool)
+
+type adminServer struct {
+	tokens *accessTokens
+	cors   []string
+}
+
+// adminAPI 管理 API 的映射表, 终结点为 /admin/<name>
+var adminAPI = map[string]func(p resultGetter) coolq.MSG{
+	"do_restart":         adminDoRestart,
+	"do_process_restart": adminDoProcessRestart,
+	"do_restart_docker":  adminDoRestartDocker,
+	"get_web_write":      adminGetWebWrite,
+	"do_web_write":       adminDoWebWrite,
+	"do_config_base":     adminDoConfigBase,
+	"do_config_http":     adminDoConfigHTTP,
+	"do_config_ws":       adminDoConfigWS,
+	"do_config_reverse":  adminDoConfigReverse,
+	"do_config_json":     adminDoConfigJSON,
+	"get_config_json":    adminGetConfigJSON,
+}
+
+// RunAdminServer 启动管理 API 服务器, 返回的函数用于停止该服务器, 启动失败时返回 nil
+//
+// 管理 API 不依赖已登录的账号, 登录完成前也会启动以便提交登录验证
+func RunAdminServer(conf *config.AdminServer) (stop func()) {
+	if conf.Disabled || conf.Port == 0 {
+		return func() {}
+	}
+	s := &adminServer{
+		tokens: newAccessTokens(&config.MiddleWares{AccessToken: conf.AccessToken}),
+		cors:   conf.CORS,
+	}
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	if conf.AccessToken == "" && !isLoopback(conf.Host) {
+		log.Errorf("管理 API 服务器 %v 未设置 access-token, 仅允许监听本机地址, 服务器将不会启动.", addr)
+		return nil
+	}
+	server := http.Server{Addr: addr, Handler: s}
+	lis, err := listen("管理 API 服务", addr)
+	if err != nil {
+		return nil
+	}
+	go func() {
+		log.Infof("管理 API 服务器已启动: %v/admin", addr)
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Warnf("管理 API 服务器 %v 出现错误: %v", addr, err)
+		}
+	}()
+	return func() {
+		_ = server.Close()
+		log.Infof("管理 API 服务器已停止: %v", addr)
+	}
+}
+
+// isLoopback 判断 host 是否为本机地址, 为空时监听所有地址, 不是本机地址
+func isLoopback(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
+
+// allowOrigin 设置跨域响应头, 来源不在 cors 列表中时不设置
+//
+// cors 包含 * 时返回 *, 以免浏览器携带 Cookie 等凭据; 所有来源均不允许携带凭据.
+func (s *adminServer) allowOrigin(writer http.ResponseWriter, origin string) {
+	if origin == "" {
+		return
+	}
+	allowed := ""
+	for _, o := range s.cors {
+		if o == "*" {
+			allowed = "*"
+			break
+		}
+		if strings.EqualFold(o, origin) {
+			allowed = origin
+		}
+	}
+	if allowed == "" {
+		return
+	}
+	h := writer.Header()
+	h.Set("Access-Control-Allow-Origin", allowed)
+	h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Request-ID")
+	if allowed != "*" {
+		h.Add("Vary", "Origin")
+	}
+}
+
+func (s *adminServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	s.allowOrigin(writer, request.Header.Get("Origin"))
+	var ctx httpCtx
+	switch request.Method {
+	case http.MethodOptions:
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodPost:
+		contentType := request.Header.Get("Content-Type")
+		switch {
+		case strings.Contains(contentType, "application/json"):
+			body, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, 1<<20))
+			if err != nil || !gjson.ValidBytes(body) {
+				writer.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			ctx.json = gjson.ParseBytes(body)
+		case strings.Contains(contentType, "multipart/form-data"):
+			if err := request.ParseMultipartForm(1 << 20); err != nil {
+				writer.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			ctx.postForm = request.PostForm
+		default:
+			if err := request.ParseForm(); err != nil {
+				writer.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			ctx.postForm = request.PostForm
+		}
+	case http.MethodGet:
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ctx.query = request.URL.Query()
+	if status, _ := checkAuth(request, s.tokens); status != http.StatusOK {
+		writer.WriteHeader(status)
+		return
+	}
+
+	name := strings.TrimPrefix(request.URL.Path, "/admin/")
+	fn, ok := adminAPI[name]
+	if !ok {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+	log.Infof("管理 API 服务器接收到调用: %v (%v)", name, request.RemoteAddr)
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(writer).Encode(fn(&ctx))
+}
+
+// RestartServers 重新加载配置文件并在 delay 后重启所有通信服务, 由 main 设置
+var RestartServers func(delay time.Duration) error
+
+// adminDoRestart 热重启: 重新加载配置文件并重启所有通信服务, 登录会话不受影响
+//
+// 通信服务在 1 秒后重启, 以便响应发送完成.
+func adminDoRestart(_ resultGetter) coolq.MSG {
+	if RestartServers == nil {
+		return coolq.Failed(100, "RELOAD_UNSUPPORTED", "登录完成前不支持重新加载配置")
+	}
+	if err := RestartServers(time.Second); err != nil {
+		return coolq.Failed(100, "RELOAD_FAILED", err.Error())
+	}
+	return coolq.OK(nil)
+}
+
+// exitLater 在 delay 后退出进程, delay 至少为 1 秒以便响应发送完成
+func exitLater(restart bool, delay time.Duration) coolq.MSG {
+	if ExitProcess == nil {
+		return coolq.Failed(100, "RESTART_UNSUPPORTED", "当前不支持重启")
+	}
+	if delay < time.Second {
+		delay = time.Second
+	}
+	time.AfterFunc(delay, func() { ExitProcess(restart) })
+	return coolq.OK(nil)
+}
+
+// adminDoProcessRestart 冷重启: 启动新的进程后退出
+func adminDoProcessRestart(_ resultGetter) coolq.MSG {
+	return exitLater(true, 0)
+}
+
+// adminDoRestartDocker 冷重启: 直接退出, 由 docker/supervisor 等进程管理工具重新拉起
+func adminDoRestartDocker(_ resultGetter) coolq.MSG {
+	return exitLater(false, 0)
+}
+
+// adminGetWebWrite 获取等待提交结果的登录验证
+//
+// ispic 与 picbase64 为最早推送的验证, challenges 为全部验证
+func adminGetWebWrite(_ resultGetter) coolq.MSG {
+	list := pendingLoginChallenges()
+	challenges := make([]coolq.MSG, 0, len(list))
+	for _, c := range list {
+		m := coolq.MSG{
+			"challenge_id": c.id,
+			"self_id":      c.uin,
+			"sub_type":     c.subType,
+			"expire":       c.expire.Unix(),
+		}
+		for k, v := range c.data {
+			m[k] = v
+		}
+		challenges = append(challenges, m)
+	}
+	ret := coolq.MSG{"ispic": false, "picbase64": "", "challenges": challenges}
+	if len(list) > 0 && list[0].subType == "captcha" {
+		ret["ispic"] = true
+		ret["picbase64"] = list[0].data["image"]
+	}
+	return coolq.OK(ret)
+}
+
+// adminDoWebWrite 提交登录验证结果, 未指定 challenge_id 时提交给最早推送的验证
+func adminDoWebWrite(p resultGetter) coolq.MSG {
+	id := p.Get("challenge_id").String()
+	if id == "" {
+		if list := pendingLoginChallenges(); len(list) > 0 {
+			id = list[0].id
+		}
+	}
+	if id == "" || !SubmitLoginChallenge(id, p.Get("input").String()) {
+		return coolq.Failed(100, "CHALLENGE_NOT_FOUND", "登录验证不存在或已过期")
+	}
+	return coolq.OK(nil)
+}
+
+// configSetter 将请求参数写入配置节点, 参数不存在时不修改
+type configSetter struct {
+	p    resultGetter
+	node *yaml.Node
+	err  error
+}
+
+func (c *configSetter) set(param string, convert func(gjson.Result) interface{}, path ...string) {
+	v := c.p.Get(param)
+	if c.err != nil || !v.Exists() || v.String() == redacted {
+		return
+	}
+	c.err = config.SetValue(c.node, convert(v), path...)
+}
+
+func asString(v gjson.Result) interface{}  { return v.String() }
+func asInt(v gjson.Result) interface{}     { return v.Int() }
+func asBool(v gjson.Result) interface{}    { return v.Bool() }
+func asNotBool(v gjson.Result) interface{} { return !v.Bool() }
+
+// editConfig 修改配置文件并重新加载
+func editConfig(fn func(root *yaml.Node) error) coolq.MSG {
+	if err := config.Edit(fn); err != nil {
+		return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+	}
+	return reloadAfterEdit()
+}
+
+func reloadAfterEdit() coolq.MSG {
+	if ReloadConfig != nil {
+		if err := ReloadConfig(); err != nil {
+			return coolq.Failed(100, "RELOAD_FAILED", err.Error())
+		}
+	}
+	return coolq.OK(nil)
+}
+
+// adminDoConfigBase 修改账号, 数据库与默认中间件的访问密钥, 账号的修改需要重启后生效
+func adminDoConfigBase(p resultGetter) coolq.MSG {
+	return editConfig(func(root *yaml.Node) error {
+		s := &configSetter{p: p, node: root}
+		s.set("uin", asInt, "account", "uin")
+		s.set("password", asString, "account", "password")
+		s.set("enable_db", asBool, "database", "leveldb", "enable")
+		s.set("access_token", asString, "default-middlewares", "access-token")
+		return s.err
+	})
+}
+
+// adminDoConfigHTTP 修改第一个 HTTP 通信服务, 不存在时添加一个
+func adminDoConfigHTTP(p resultGetter) coolq.MSG {
+	return editConfig(func(root *yaml.Node) error {
+		s := &configSetter{p: p, node: config.ServerNode(root, "http")}
+		s.set("host", asString, "host")
+		s.set("port", asInt, "port")
+		s.set("enable", asNotBool, "disabled")
+		s.set("timeout", asInt, "timeout")
+		if s.err == nil && p.Get("post_url").Exists() {
+			post := []map[string]string{}
+			if url := p.Get("post_url").String(); url != "" {
+				post = append(post, map[string]string{"url": url, "secret": p.Get("post_secret").String()})
+			}
+			s.err = config.SetValue(s.node, post, "post")
+		}
+		return s.err
+	})
+}
+
+// adminDoConfigWS 修改第一个正向 Websocket 通信服务, 不存在时添加一个
+func adminDoConfigWS(p resultGetter) coolq.MSG {
+	return editConfig(func(root *yaml.Node) error {
+		s := &configSetter{p: p, node: config.ServerNode(root, "ws")}
+		s.set("host", asString, "host")
+		s.set("port", asInt, "port")
+		s.set("enable", asNotBool, "disabled")
+		return s.err
+	})
+}
+
+// adminDoConfigReverse 修改第一个反向 Websocket 通信服务, 不存在时添加一个
+//
+// 未指定 universal 时使用 host 与 port 组成 Universal 地址
+func adminDoConfigReverse(p resultGetter) coolq.MSG {
+	return editConfig(func(root *yaml.Node) error {
+		s := &configSetter{p: p, node: config.ServerNode(root, "ws-reverse")}
+		s.set("universal", asString, "universal")
+		s.set("api", asString, "api")
+		s.set("event", asString, "event")
+		s.set("reconnect_interval", asInt, "reconnect-interval")
+		s.set("enable", asNotBool, "disabled")
+		if s.err == nil && !p.Get("universal").Exists() && p.Get("host").Exists() {
+			addr := fmt.Sprintf("ws://%s:%d", p.Get("host").String(), p.Get("port").Int())
+			s.err = config.SetValue(s.node, addr, "universal")
+		}
+		return s.err
+	})
+}
+
+// adminDoConfigJSON 使用 json 参数替换整个配置文件, 其内容也可以为 YAML
+//
+// 值为 redacted 的密码与密钥保留配置文件中原有的值, 以便提交 get_config_json 的结果.
+func adminDoConfigJSON(p resultGetter) coolq.MSG {
+	data := []byte(p.Get("json").String())
+	if strings.TrimSpace(string(data)) == "" {
+		return coolq.Failed(100, "CONFIG_INVALID", "json 不能为空")
+	}
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+	}
+	if old, err := readConfig(); err == nil && restoreSecrets(v, old) {
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+		}
+		data = b
+	}
+	if err := config.WriteFile(data); err != nil {
+		return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+	}
+	return reloadAfterEdit()
+}
+
+// readConfig 读取并解析当前的配置文件
+func readConfig() (interface{}, error) {
+	data, err := config.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err = yaml.Unmarshal(data, &v); err != nil {
+		return nil, errors.Wrap(err, "decode config error")
+	}
+	return v, nil
+}
+
+// redacted 替换配置中密码与密钥的值
+const redacted = "******"
+
+// secretKeys 值为密码或密钥的配置项
+var secretKeys = map[string]struct{}{
+	"password":     {},
+	"access-token": {},
+	"token":        {},
+	"secret":       {},
+	"access-key":   {},
+	"secret-key":   {},
+}
+
+// redactSecrets 将 v 中的密码, 密钥与 url 中的密码替换为 redacted
+func redactSecrets(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			s, ok := val.(string)
+			switch {
+			case !ok:
+				redactSecrets(val)
+			case s == "":
+			case isSecretKey(k):
+				v[k] = redacted
+			case k == "url":
+				if u, err := url.Parse(s); err == nil && u.User != nil {
+					if _, ok := u.User.Password(); ok {
+						v[k] = strings.Replace(s, u.User.String()+"@", redactedUserinfo(u.User), 1)
+					}
+				}
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			redactSecrets(val)
+		}
+	}
+}
+
+func isSecretKey(k string) bool {
+	_, ok := secretKeys[k]
+	return ok
+}
+
+// restoreSecrets 将 v 中值为 redacted 的配置项恢复为 old 中相同位置的值, 返回是否有修改
+func restoreSecrets(v, old interface{}) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		o, _ := old.(map[string]interface{})
+		for k, val := range v {
+			if s, ok := val.(string); ok {
+				if prev, ok := o[k].(string); ok {
+					if r := restoreSecret(k, s, prev); r != s {
+						v[k] = r
+						changed = true
+					}
+				}
+				continue
+			}
+			if restoreSecrets(val, o[k]) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		o, _ := old.([]interface{})
+		for i, val := range v {
+			var prev interface{}
+			if i < len(o) {
+				prev = o[i]
+			}
+			if restoreSecrets(val, prev) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// restoreSecret 返回恢复后的配置项 k 的值, s 为提交的值, prev 为原有的值
+func restoreSecret(k, s, prev string) string {
+	if isSecretKey(k) {
+		if s == redacted {
+			return prev
+		}
+		return s
+	}
+	if k != "url" || !strings.Contains(s, redacted) {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if p, _ := u.User.Password(); p != redacted {
+		return s
+	}
+	pu, err := url.Parse(prev)
+	if err != nil || pu.User == nil || pu.User.Username() != u.User.Username() {
+		return s
+	}
+	if _, ok := pu.User.Password(); !ok {
+		return s
+	}
+	return strings.Replace(s, redactedUserinfo(u.User), pu.User.String()+"@", 1)
+}
+
+// redactedUserinfo 返回 url 中密码替换为 redacted 后的 userinfo, 包含结尾的 @
+func redactedUserinfo(user *url.Userinfo) string {
+	return url.User(user.Username()).String() + ":" + redacted + "@"
+}
+
+// adminGetConfigJSON 以 JSON 字符串的形式返回当前配置文件, 锚点引用将被展开
+//
+// 密码, 访问密钥等敏感的值将被替换为 redacted.
+func adminGetConfigJSON(_ resultGetter) coolq.MSG {
+	v, err := readConfig()
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return coolq.Failed(100, "CONFIG_NOT_FOUND", "配置文件不存在")
+		}
+		return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+	}
+	redactSecrets(v)
+	b, err := json.Marshal(v)
+	if err != nil {
+		return coolq.Failed(100, "CONFIG_INVALID", err.Error())
+	}
+	return coolq.OK(coolq.MSG{"config": string(b)})
+}
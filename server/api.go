@@ -1,11 +1,16 @@
 package server
 
 import (
+	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/Mrs4s/go-cqhttp/coolq"
 	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
 )
 
@@ -15,9 +20,31 @@ type resultGetter interface {
 
 type handler func(action string, p resultGetter) coolq.MSG
 
+var (
+	shuttingDown  int32 // 为 1 时拒绝新的 API 调用
+	inflightCalls int32 // 正在处理的 API 调用数
+)
+
+// StopAcceptingAPI 拒绝之后的所有 API 调用, 并等待正在处理的调用完成, 超过 timeout 后返回 false
+func StopAcceptingAPI(timeout time.Duration) bool {
+	atomic.StoreInt32(&shuttingDown, 1)
+	deadline := time.Now().Add(timeout)
+	for atomic.LoadInt32(&inflightCalls) > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return true
+}
+
 type apiCaller struct {
 	bot      *coolq.CQBot
 	handlers []handler
+	scope    *tokenScope
+	selfID   int64      // 未指定 self_id 参数时使用的账号, 仅在 bot 为 nil 时有效
+	v12      bool       // 使用 OneBot v12 的动作与响应格式
+	log      *log.Entry // 本次调用的日志, 附带 request_id, echo 等字段
 }
 
 func getLoginInfo(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
@@ -56,24 +83,25 @@ func getGroupMemberInfo(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 
 func sendMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 	autoEscape := global.EnsureBool(p.Get("auto_escape"), false)
+	priority := coolq.ParseSendPriority(p.Get("priority").String())
 	if p.Get("message_type").Str == "private" {
-		return bot.CQSendPrivateMessage(p.Get("user_id").Int(), p.Get("group_id").Int(), p.Get("message"), autoEscape)
+		return bot.CQSendPrivateMessage(p.Get("user_id").Int(), p.Get("group_id").Int(), p.Get("message"), autoEscape, priority)
 	}
 	if p.Get("message_type").Str == "group" {
-		return bot.CQSendGroupMessage(p.Get("group_id").Int(), p.Get("message"), autoEscape)
+		return bot.CQSendGroupMessage(p.Get("group_id").Int(), p.Get("message"), autoEscape, priority)
 	}
 	if p.Get("user_id").Int() != 0 {
-		return bot.CQSendPrivateMessage(p.Get("user_id").Int(), p.Get("group_id").Int(), p.Get("message"), autoEscape)
+		return bot.CQSendPrivateMessage(p.Get("user_id").Int(), p.Get("group_id").Int(), p.Get("message"), autoEscape, priority)
 	}
 	if p.Get("group_id").Int() != 0 {
-		return bot.CQSendGroupMessage(p.Get("group_id").Int(), p.Get("message"), autoEscape)
+		return bot.CQSendGroupMessage(p.Get("group_id").Int(), p.Get("message"), autoEscape, priority)
 	}
 	return coolq.MSG{}
 }
 
 func sendGroupMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 	return bot.CQSendGroupMessage(p.Get("group_id").Int(), p.Get("message"),
-		global.EnsureBool(p.Get("auto_escape"), false))
+		global.EnsureBool(p.Get("auto_escape"), false), coolq.ParseSendPriority(p.Get("priority").String()))
 }
 
 func sendGroupForwardMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
@@ -82,7 +110,7 @@ func sendGroupForwardMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 
 func sendPrivateMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 	return bot.CQSendPrivateMessage(p.Get("user_id").Int(), p.Get("group_id").Int(), p.Get("message"),
-		global.EnsureBool(p.Get("auto_escape"), false))
+		global.EnsureBool(p.Get("auto_escape"), false), coolq.ParseSendPriority(p.Get("priority").String()))
 }
 
 func deleteMSG(bot *coolq.CQBot, p resultGetter) coolq.MSG {
@@ -203,19 +231,9 @@ func getGroupHonorInfo(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 	return bot.CQGetGroupHonorInfo(p.Get("group_id").Int(), p.Get("type").Str)
 }
 
+// setRestart 重启进程仅可通过管理 API 调用, 以免持有访问令牌的客户端均可重启进程
 func setRestart(_ *coolq.CQBot, _ resultGetter) coolq.MSG {
-	/*
-		var delay int64
-		delay = p.Get("delay").Int()
-		if delay < 0 {
-			delay = 0
-		}
-		defer func(delay int64) {
-			time.Sleep(time.Duration(delay) * time.Millisecond)
-			Restart <- struct{}{}
-		}(delay)
-	*/
-	return coolq.MSG{"data": nil, "retcode": 99, "msg": "restart un-supported now", "wording": "restart函数暂不兼容", "status": "failed"}
+	return coolq.Failed(100, "RESTART_UNSUPPORTED", "请使用管理 API 的 admin/do_process_restart 重启")
 }
 
 func canSendImage(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
@@ -287,6 +305,54 @@ func reloadEventFilter(_ *coolq.CQBot, p resultGetter) coolq.MSG {
 	return coolq.OK(nil)
 }
 
+// testEventFilter 使用 filter 或 file 中的过滤规则对 event 执行过滤, 返回拒绝该事件的节点
+//
+// file 仅可为配置文件中已加载的过滤器, 以免通过该 API 读取任意文件.
+func testEventFilter(_ *coolq.CQBot, p resultGetter) (ret coolq.MSG) {
+	var filter global.Filter
+	rule := p.Get("filter")
+	if rule.Type == gjson.String {
+		rule = gjson.Parse(rule.Str)
+	}
+	if !rule.Exists() {
+		file := p.Get("file").String()
+		if file == "" {
+			return coolq.Failed(100, "INVALID_FILTER", "filter 与 file 不能同时为空")
+		}
+		if filter = findFilter(file); filter == nil {
+			return coolq.Failed(100, "FILTER_NOT_FOUND", "过滤器未在配置文件中使用或加载失败")
+		}
+	}
+	event := p.Get("event")
+	if event.Type == gjson.String {
+		event = gjson.Parse(event.Str)
+	}
+	if !event.IsObject() {
+		return coolq.Failed(100, "INVALID_EVENT", "event 必须为对象")
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			ret = coolq.Failed(100, "INVALID_FILTER", fmt.Sprint(err))
+		}
+	}()
+	if filter == nil {
+		filter = global.Generate("and", rule)
+	}
+	passed, node := global.Explain(filter, event)
+	return coolq.OK(coolq.MSG{"passed": passed, "rejected_by": node})
+}
+
+func submitLoginChallenge(_ *coolq.CQBot, p resultGetter) coolq.MSG {
+	if !SubmitLoginChallenge(p.Get("challenge_id").String(), p.Get("answer").String()) {
+		return coolq.Failed(100, "CHALLENGE_NOT_FOUND", "登录验证不存在或已过期")
+	}
+	return coolq.OK(nil)
+}
+
+func getEventsSince(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	return bot.CQGetEventsSince(p.Get("seq").Uint(), p.Get("since").Int(), int(p.Get("limit").Int()))
+}
+
 func getGroupAtAllRemain(bot *coolq.CQBot, p resultGetter) coolq.MSG {
 	return bot.CQGetAtAllRemain(p.Get("group_id").Int())
 }
@@ -398,6 +464,11 @@ var API = map[string]func(*coolq.CQBot, resultGetter) coolq.MSG{
 	"get_group_msg_history":      getGroupMsgHistory,
 	"_get_vip_info":              getVipInfo,
 	"reload_event_filter":        reloadEventFilter,
+	"test_event_filter":          testEventFilter,
+	"submit_login_challenge":     submitLoginChallenge,
+	"get_pending_events":         getPendingEvents,
+	"flush_event_queue":          flushEventQueue,
+	"get_events_since":           getEventsSince,
 	".ocr_image":                 ocrImage,
 	"ocr_image":                  ocrImage,
 	"get_group_at_all_remain":    getGroupAtAllRemain,
@@ -413,24 +484,149 @@ var API = map[string]func(*coolq.CQBot, resultGetter) coolq.MSG{
 	"qidian_get_account_info":    getQiDianAccountInfo,
 	"_get_model_show":            getModelShow,
 	"_set_model_show":            setModelShow,
+	"reload_config":              reloadConfig,
+	"get_task_result":            getTaskResult,
 }
 
-func (api *apiCaller) callAPI(action string, p resultGetter) coolq.MSG {
+func (api *apiCaller) callAPI(action string, p resultGetter) (ret coolq.MSG) {
+	if name, ok := isAsync(action); ok {
+		ret = api.callAsync(name, p)
+		if api.v12 {
+			ret = v12Result(ret)
+		}
+		return ret
+	}
+	if action == batchAction { // 批量调用中的每个调用单独转换响应格式
+		ret = api.callBatch(p)
+		if api.v12 {
+			ret = v12Result(ret)
+		}
+		return ret
+	}
+	start := time.Now()
+	f, ok := api.findAction(action)
+	entry := api.logger().WithField("action", action)
+	defer func() {
+		name := action
+		if !ok {
+			name = "unknown" // 避免任意的 action 造成指标标签膨胀
+		}
+		metrics.APICalls.Inc(name, fmt.Sprint(ret["retcode"]))
+		metrics.APIDuration.Observe(time.Since(start).Seconds(), name)
+		if data, ok := ret["data"].(coolq.MSG); ok && data["message_id"] != nil {
+			entry = entry.WithField("message_id", data["message_id"])
+		}
+		entry.Debugf("API调用 %v 已完成, retcode: %v", action, ret["retcode"])
+	}()
+	if api.v12 {
+		defer func() { ret = v12Result(ret) }()
+	}
+	if atomic.LoadInt32(&shuttingDown) == 1 {
+		return coolq.Failed(503, "SHUTTING_DOWN", "go-cqhttp 正在退出")
+	}
+	atomic.AddInt32(&inflightCalls, 1)
+	defer atomic.AddInt32(&inflightCalls, -1)
+	bot := api.findBot(p)
+	if bot != nil {
+		entry = entry.WithField("self_id", bot.Client.Uin)
+	}
+	if ret := api.checkScope(bot, action, p); ret != nil {
+		return ret
+	}
 	for _, fn := range api.handlers {
 		if ret := fn(action, p); ret != nil {
 			return ret
 		}
 	}
-	if f, ok := API[action]; ok {
-		return f(api.bot, p)
+	if !ok {
+		return coolq.Failed(404, "API_NOT_FOUND", "API不存在")
+	}
+	if bot == nil {
+		return coolq.Failed(404, "BOT_NOT_FOUND", "账号不存在或尚未登录")
 	}
-	return coolq.Failed(404, "API_NOT_FOUND", "API不存在")
+	return f(bot, p)
+}
+
+// checkScope 检查令牌是否有权调用 action, v12 模式下同时按实际调用的 v11 API 检查
+func (api *apiCaller) checkScope(bot *coolq.CQBot, action string, p resultGetter) coolq.MSG {
+	if ret := api.scope.check(bot, action, p); ret != nil {
+		return ret
+	}
+	if api.v12 {
+		if name := v11Action(action, p); name != "" && name != action {
+			return api.scope.check(bot, name, p)
+		}
+	}
+	return nil
+}
+
+// findAction 返回 action 对应的 API, v12 模式下使用 v12 的动作
+func (api *apiCaller) findAction(action string) (func(*coolq.CQBot, resultGetter) coolq.MSG, bool) {
+	if api.v12 {
+		return findV12Action(action)
+	}
+	f, ok := API[action]
+	return f, ok
+}
+
+// findBot 返回调用的账号, 依次使用 bot, self_id 参数与默认账号
+func (api *apiCaller) findBot(p resultGetter) *coolq.CQBot {
+	if api.bot != nil {
+		return api.bot
+	}
+	selfID := p.Get("self_id").Int()
+	if selfID == 0 {
+		selfID = api.selfID
+	}
+	return findBot(selfID)
 }
 
 func (api *apiCaller) use(middlewares ...handler) {
 	api.handlers = append(api.handlers, middlewares...)
 }
 
+// withScope 返回一个使用 scope 限制权限的 apiCaller, 中间件与原 apiCaller 共享
+func (api *apiCaller) withScope(scope *tokenScope) *apiCaller {
+	if scope == nil {
+		return api
+	}
+	c := *api
+	c.scope = scope
+	return &c
+}
+
+// withSelfID 返回一个默认调用账号 selfID 的 apiCaller, 用于多账号共享的通信服务
+func (api *apiCaller) withSelfID(selfID int64) *apiCaller {
+	if api.bot != nil || selfID == 0 {
+		return api
+	}
+	c := *api
+	c.selfID = selfID
+	return &c
+}
+
+// withLog 返回一个在调用日志中附加 fields 的 apiCaller
+func (api *apiCaller) withLog(fields log.Fields) *apiCaller {
+	c := *api
+	c.log = api.logger().WithFields(fields)
+	return &c
+}
+
+// logger 返回本次调用的日志
+func (api *apiCaller) logger() *log.Entry {
+	if api.log == nil {
+		return log.NewEntry(log.StandardLogger())
+	}
+	return api.log
+}
+
+// withBot 返回固定调用 bot 的 apiCaller
+func (api *apiCaller) withBot(bot *coolq.CQBot) *apiCaller {
+	c := *api
+	c.bot = bot
+	return &c
+}
+
 func newAPICaller(bot *coolq.CQBot) *apiCaller {
 	return &apiCaller{
 		bot:      bot,
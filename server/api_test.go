@@ -0,0 +1,52 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestTestEventFilter(t *testing.T) {
+	loaded := filepath.Join(t.TempDir(), "filter.json")
+	assert.NoError(t, os.WriteFile(loaded, []byte(`{"group_id":{".in":[1]}}`), 0o644))
+	addFilter(loaded)
+	unloaded := filepath.Join(t.TempDir(), "secret.json")
+	assert.NoError(t, os.WriteFile(unloaded, []byte(`{"password":"secret"}`), 0o644))
+
+	var tests = [...]struct {
+		params   string
+		msg      string
+		passed   bool
+		rejected string
+	}{
+		{`{"filter":{"group_id":1},"event":{"group_id":1}}`, "", true, ""},
+		{`{"filter":"{\"group_id\":{\".neq\":1}}","event":"{\"group_id\":1}"}`, "", false, "group_id/.neq"},
+		{`{"file":"` + loaded + `","event":{"group_id":2}}`, "", false, "group_id/.in"},
+		{`{"file":"` + unloaded + `","event":{"group_id":2}}`, "FILTER_NOT_FOUND", false, ""},
+		{`{"event":{"group_id":2}}`, "INVALID_FILTER", false, ""},
+		{`{"filter":{"group_id":1},"event":1}`, "INVALID_EVENT", false, ""},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			ret := testEventFilter(nil, gjson.Parse(tests[i].params))
+			if tests[i].msg != "" {
+				assert.Equal(t, tests[i].msg, ret["msg"])
+				assert.NotContains(t, ret["wording"], "secret")
+				return
+			}
+			j := gjson.Parse(toJSON(t, ret["data"]))
+			assert.Equal(t, tests[i].passed, j.Get("passed").Bool())
+			assert.Equal(t, tests[i].rejected, j.Get("rejected_by").String())
+		})
+	}
+}
+
+func toJSON(t *testing.T, v interface{}) string {
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return string(b)
+}
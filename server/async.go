@@ -0,0 +1,170 @@
+package server
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+const asyncSuffix = "_async"
+
+// asyncTask 一次 _async 调用
+type asyncTask struct {
+	id       string
+	action   string
+	api      *apiCaller
+	bot      *coolq.CQBot
+	params   resultGetter
+	status   string // pending, running, done
+	result   coolq.MSG
+	finished time.Time
+}
+
+// asyncPool 执行 _async 调用的有界协程池
+var asyncPool = struct {
+	sync.Mutex
+	once    sync.Once
+	conf    config.AsyncConfig
+	queue   chan *asyncTask
+	tasks   map[string]*asyncTask
+	cleaned time.Time
+}{
+	conf:  normalizeAsyncConfig(config.AsyncConfig{}),
+	tasks: make(map[string]*asyncTask),
+}
+
+func normalizeAsyncConfig(conf config.AsyncConfig) config.AsyncConfig {
+	if conf.Workers <= 0 {
+		conf.Workers = 8
+	}
+	if conf.QueueSize <= 0 {
+		conf.QueueSize = 256
+	}
+	if conf.ResultTTL <= 0 {
+		conf.ResultTTL = 300
+	}
+	return conf
+}
+
+// SetAsyncConfig 应用 _async 调用的配置, 协程数与队列长度在第一次异步调用后不再改变
+func SetAsyncConfig(conf *config.AsyncConfig) {
+	asyncPool.Lock()
+	asyncPool.conf = normalizeAsyncConfig(*conf)
+	asyncPool.Unlock()
+}
+
+func startAsyncWorkers() {
+	asyncPool.Lock()
+	workers, size := asyncPool.conf.Workers, asyncPool.conf.QueueSize
+	asyncPool.Unlock()
+	asyncPool.queue = make(chan *asyncTask, size)
+	for i := 0; i < workers; i++ {
+		go asyncWorker()
+	}
+}
+
+func asyncWorker() {
+	for task := range asyncPool.queue {
+		asyncPool.Lock()
+		task.status = "running"
+		asyncPool.Unlock()
+		ret := task.api.callAPI(task.action, task.params)
+		asyncPool.Lock()
+		task.status = "done"
+		task.result = ret
+		task.finished = time.Now()
+		report := asyncPool.conf.ReportEvent
+		asyncPool.Unlock()
+		task.api.logger().Debugf("异步调用 %v(%v) 已完成, retcode: %v", task.action, task.id, ret["retcode"])
+		if report {
+			task.bot.PushEvent(coolq.MSG{
+				"time":        time.Now().Unix(),
+				"self_id":     task.bot.Client.Uin,
+				"post_type":   "notice",
+				"notice_type": "async_result",
+				"task_id":     task.id,
+				"action":      task.action,
+				"result":      ret,
+			})
+		}
+	}
+}
+
+// callAsync 将调用加入协程池并立即返回 task_id, 调用结果可通过 get_task_result 获取
+func (api *apiCaller) callAsync(action string, p resultGetter) coolq.MSG {
+	asyncPool.once.Do(startAsyncWorkers)
+	if atomic.LoadInt32(&shuttingDown) == 1 {
+		return coolq.Failed(503, "SHUTTING_DOWN", "go-cqhttp 正在退出")
+	}
+	bot := api.findBot(p)
+	if ret := api.checkScope(bot, action, p); ret != nil {
+		return ret
+	}
+	if _, ok := api.findAction(action); !ok && action != batchAction {
+		return coolq.Failed(404, "API_NOT_FOUND", "API不存在")
+	}
+	if bot == nil {
+		return coolq.Failed(404, "BOT_NOT_FOUND", "账号不存在或尚未登录")
+	}
+	// WebSocket 的请求使用复用的缓冲区, 需要复制参数
+	if r, ok := p.(gjson.Result); ok {
+		p = gjson.Parse(string(append([]byte(nil), r.Raw...)))
+	}
+	task := &asyncTask{
+		id:     newRandomID(),
+		action: action,
+		api:    api.withBot(bot),
+		bot:    bot,
+		params: p,
+		status: "pending",
+	}
+	asyncPool.Lock()
+	defer asyncPool.Unlock()
+	now := time.Now()
+	ttl := time.Second * time.Duration(asyncPool.conf.ResultTTL)
+	if now.Sub(asyncPool.cleaned) > time.Minute {
+		asyncPool.cleaned = now
+		for id, t := range asyncPool.tasks {
+			if t.status == "done" && now.Sub(t.finished) > ttl {
+				delete(asyncPool.tasks, id)
+			}
+		}
+	}
+	select {
+	case asyncPool.queue <- task:
+	default:
+		return coolq.Failed(503, "ASYNC_QUEUE_FULL", "异步调用队列已满")
+	}
+	asyncPool.tasks[task.id] = task
+	return coolq.MSG{"data": coolq.MSG{"task_id": task.id}, "retcode": 1, "status": "async"}
+}
+
+func getTaskResult(_ *coolq.CQBot, p resultGetter) coolq.MSG {
+	asyncPool.Lock()
+	defer asyncPool.Unlock()
+	task, ok := asyncPool.tasks[p.Get("task_id").String()]
+	ttl := time.Second * time.Duration(asyncPool.conf.ResultTTL)
+	if !ok || (task.status == "done" && time.Since(task.finished) > ttl) {
+		return coolq.Failed(100, "TASK_NOT_FOUND", "任务不存在或已过期")
+	}
+	return coolq.OK(coolq.MSG{
+		"task_id": task.id,
+		"action":  task.action,
+		"status":  task.status,
+		"result":  task.result,
+	})
+}
+
+// isAsync 判断 action 是否为异步调用, 并返回去除后缀的 action
+func isAsync(action string) (string, bool) {
+	if strings.HasSuffix(action, asyncSuffix) {
+		return strings.TrimSuffix(action, asyncSuffix), true
+	}
+	return action, false
+}
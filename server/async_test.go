@@ -0,0 +1,56 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Mrs4s/MiraiGo/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+func TestIsAsync(t *testing.T) {
+	var tests = [...]struct {
+		action   string
+		expected string
+		async    bool
+	}{
+		{"send_msg", "send_msg", false},
+		{"send_msg_async", "send_msg", true},
+		{".batch_async", ".batch", true},
+		{"_async", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			action, async := isAsync(tt.action)
+			assert.Equal(t, tt.expected, action)
+			assert.Equal(t, tt.async, async)
+		})
+	}
+}
+
+func TestCallAsync(t *testing.T) {
+	api := newAPICaller(&coolq.CQBot{Client: &client.QQClient{Uin: 1}})
+	api.use(func(action string, p resultGetter) coolq.MSG {
+		return coolq.OK(p.Get("n").Int())
+	})
+
+	ret := api.callAPI("get_status_async", gjson.Parse(`{"n":1}`))
+	assert.Equal(t, "async", ret["status"])
+	id := ret["data"].(coolq.MSG)["task_id"].(string)
+	var res coolq.MSG
+	assert.Eventually(t, func() bool {
+		res = getTaskResult(nil, gjson.Parse(`{"task_id":"`+id+`"}`))
+		return res["data"].(coolq.MSG)["status"] == "done"
+	}, time.Second, time.Millisecond*10)
+	assert.Equal(t, coolq.OK(int64(1)), res["data"].(coolq.MSG)["result"])
+
+	ret = api.callAPI("not_exists_async", gjson.Parse(`{}`))
+	assert.Equal(t, "API_NOT_FOUND", ret["msg"])
+	ret = newAPICaller(nil).callAPI("get_status_async", gjson.Parse(`{"self_id":1}`))
+	assert.Equal(t, "BOT_NOT_FOUND", ret["msg"])
+	ret = getTaskResult(nil, gjson.Parse(`{"task_id":"unknown"}`))
+	assert.Equal(t, "TASK_NOT_FOUND", ret["msg"])
+}
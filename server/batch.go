@@ -0,0 +1,100 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+const batchAction = ".batch"
+
+const (
+	batchMaxActions     = 100 // 一次批量调用最多包含的调用数
+	batchMaxConcurrency = 16  // 同时执行的调用数上限
+)
+
+// callBatch 依次或并发执行 actions 中的调用, 每个调用都经过权限检查与中间件
+//
+// 参数 concurrency 为同时执行的调用数, 默认为 1, 最大为 batchMaxConcurrency;
+// stop_on_error 为 true 时, 一个调用失败后尚未开始的调用将被跳过.
+func (api *apiCaller) callBatch(p resultGetter) coolq.MSG {
+	actions := p.Get("actions")
+	if !actions.IsArray() {
+		return coolq.Failed(100, "INVALID_ACTIONS", "actions 必须为数组")
+	}
+	calls := actions.Array()
+	if len(calls) > batchMaxActions {
+		return coolq.Failed(100, "TOO_MANY_ACTIONS", fmt.Sprintf("一次最多执行 %d 个调用", batchMaxActions))
+	}
+	api = api.withSelfID(p.Get("self_id").Int())
+	results := make([]coolq.MSG, len(calls))
+	concurrency := int(p.Get("concurrency").Int())
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > batchMaxConcurrency {
+		concurrency = batchMaxConcurrency
+	}
+	if concurrency > len(calls) {
+		concurrency = len(calls)
+	}
+	stopOnError := p.Get("stop_on_error").Bool()
+
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		next    int
+		aborted bool
+	)
+	worker := func() {
+		defer wg.Done()
+		for {
+			lock.Lock()
+			if next >= len(calls) {
+				lock.Unlock()
+				return
+			}
+			i := next
+			next++
+			skip := aborted
+			lock.Unlock()
+
+			var ret coolq.MSG
+			if skip {
+				ret = coolq.Failed(100, "BATCH_ABORTED", "之前的调用失败, 已跳过")
+			} else {
+				ret = api.callBatchItem(calls[i])
+			}
+			if echo := calls[i].Get("echo"); echo.Exists() {
+				ret["echo"] = echo.Value()
+			}
+			results[i] = ret
+			if stopOnError && ret["status"] == "failed" {
+				lock.Lock()
+				aborted = true
+				lock.Unlock()
+			}
+		}
+	}
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go worker()
+	}
+	wg.Wait()
+	return coolq.OK(results)
+}
+
+func (api *apiCaller) callBatchItem(call gjson.Result) coolq.MSG {
+	action := call.Get("action").String()
+	if name, _ := isAsync(action); name == batchAction {
+		return coolq.Failed(100, "NESTED_BATCH", "不支持嵌套的批量调用")
+	}
+	if echo := call.Get("echo"); echo.Exists() {
+		return api.withLog(log.Fields{"echo": echo.Value()}).callAPI(action, call.Get("params"))
+	}
+	return api.callAPI(action, call.Get("params"))
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+// batchRecorder 记录批量调用中实际执行的调用, action 为 fail 时调用失败
+type batchRecorder struct {
+	sync.Mutex
+	calls []string
+}
+
+func (r *batchRecorder) handle(action string, p resultGetter) coolq.MSG {
+	r.Lock()
+	r.calls = append(r.calls, action)
+	r.Unlock()
+	if action == "fail" {
+		return coolq.Failed(100, "FAILED", "")
+	}
+	return coolq.OK(p.Get("n").Int())
+}
+
+func TestCallBatch(t *testing.T) {
+	var tests = [...]struct {
+		params   string
+		statuses []string
+		calls    int
+	}{
+		{`{"actions":[{"action":"a","params":{"n":1}},{"action":"fail"},{"action":"b"}]}`, []string{"ok", "failed", "ok"}, 3},
+		{`{"actions":[{"action":"a"},{"action":"fail"},{"action":"b"},{"action":"c"}],"stop_on_error":true}`, []string{"ok", "failed", "failed", "failed"}, 2},
+		{`{"actions":[{"action":"fail"},{"action":"a"}],"stop_on_error":false}`, []string{"failed", "ok"}, 2},
+		{`{"actions":[{"action":"a"},{"action":".batch","params":{"actions":[]}}]}`, []string{"ok", "failed"}, 1},
+		{`{"actions":[{"action":"a"},{"action":"b"},{"action":"c"}],"concurrency":8}`, []string{"ok", "ok", "ok"}, 3},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			r := &batchRecorder{}
+			api := newAPICaller(nil)
+			api.use(r.handle)
+			ret := api.callBatch(gjson.Parse(tests[i].params))
+			assert.Equal(t, "ok", ret["status"])
+			results := ret["data"].([]coolq.MSG)
+			var statuses []string
+			for _, res := range results {
+				statuses = append(statuses, res["status"].(string))
+			}
+			assert.Equal(t, tests[i].statuses, statuses)
+			assert.Len(t, r.calls, tests[i].calls)
+		})
+	}
+}
+
+func TestCallBatchResult(t *testing.T) {
+	api := newAPICaller(nil)
+	api.use((&batchRecorder{}).handle)
+	ret := api.callBatch(gjson.Parse(`{"actions":[{"action":"a","params":{"n":1},"echo":"x"},{"action":"fail"},{"action":"b"}],"stop_on_error":true}`))
+	results := ret["data"].([]coolq.MSG)
+	assert.Equal(t, int64(1), results[0]["data"])
+	assert.Equal(t, "x", results[0]["echo"])
+	assert.Equal(t, "BATCH_ABORTED", results[2]["msg"])
+
+	ret = api.callBatch(gjson.Parse(`{"actions":{}}`))
+	assert.Equal(t, "INVALID_ACTIONS", ret["msg"])
+}
+
+func TestCallBatchLimit(t *testing.T) {
+	r := &batchRecorder{}
+	api := newAPICaller(nil)
+	api.use(r.handle)
+	actions := `{"action":"a"}` + strings.Repeat(`,{"action":"a"}`, batchMaxActions)
+	ret := api.callBatch(gjson.Parse(`{"actions":[` + actions + `]}`))
+	assert.Equal(t, "TOO_MANY_ACTIONS", ret["msg"])
+	assert.Empty(t, r.calls)
+
+	// 同时执行的调用数不超过 batchMaxConcurrency
+	var running, peak int32
+	api = newAPICaller(nil)
+	api.use(func(string, resultGetter) coolq.MSG {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 10)
+		atomic.AddInt32(&running, -1)
+		return coolq.OK(nil)
+	})
+	actions = `{"action":"a"}` + strings.Repeat(`,{"action":"a"}`, batchMaxActions-1)
+	ret = api.callBatch(gjson.Parse(`{"actions":[` + actions + `],"concurrency":1000}`))
+	assert.Len(t, ret["data"], batchMaxActions)
+	assert.LessOrEqual(t, int(peak), batchMaxConcurrency)
+}
+
+func TestCallBatchLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	api := newAPICaller(nil)
+	api.use((&batchRecorder{}).handle)
+	api.log = logrus.NewEntry(logger)
+	api.withLog(logrus.Fields{"request_id": "r"}).
+		callBatch(gjson.Parse(`{"actions":[{"action":"a","echo":"x"},{"action":"b"}],"concurrency":2}`))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	for _, line := range lines {
+		entry := gjson.Parse(line)
+		assert.Equal(t, "r", entry.Get("request_id").String())
+		if entry.Get("action").String() == "a" {
+			assert.Equal(t, "x", entry.Get("echo").String())
+		} else {
+			assert.False(t, entry.Get("echo").Exists())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+// 已登录的账号, 用于多账号模式下共享的通信服务按 self_id 路由
+var (
+	bots     []*coolq.CQBot
+	botsLock sync.RWMutex
+)
+
+// RegisterBot 注册一个已登录的账号, 第一个注册的账号为默认账号
+func RegisterBot(bot *coolq.CQBot) {
+	botsLock.Lock()
+	bots = append(bots, bot)
+	botsLock.Unlock()
+}
+
+// UnregisterBot 移除已停止的账号
+func UnregisterBot(bot *coolq.CQBot) {
+	botsLock.Lock()
+	defer botsLock.Unlock()
+	rest := make([]*coolq.CQBot, 0, len(bots))
+	for _, b := range bots {
+		if b != bot {
+			rest = append(rest, b)
+		}
+	}
+	bots = rest
+}
+
+// findBot 返回 selfID 对应的账号, selfID 为 0 时返回默认账号
+func findBot(selfID int64) *coolq.CQBot {
+	botsLock.RLock()
+	defer botsLock.RUnlock()
+	if len(bots) == 0 {
+		return nil
+	}
+	if selfID == 0 {
+		return bots[0]
+	}
+	for _, b := range bots {
+		if b.Client.Uin == selfID {
+			return b
+		}
+	}
+	return nil
+}
+
+// botsOf 返回通信服务需要服务的账号, bot 为 nil 时返回所有已注册的账号
+func botsOf(bot *coolq.CQBot) []*coolq.CQBot {
+	if bot != nil {
+		return []*coolq.CQBot{bot}
+	}
+	botsLock.RLock()
+	defer botsLock.RUnlock()
+	return append([]*coolq.CQBot(nil), bots...)
+}
+
+// parseSelfID 解析 X-Self-ID 请求头
+func parseSelfID(s string) int64 {
+	id, _ := strconv.ParseInt(s, 10, 64)
+	return id
+}
@@ -0,0 +1,259 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// brokerClient 消息队列客户端, 由 redis, nats 与 mqtt 分别实现
+type brokerClient interface {
+	// publish 将 payload 发布到 topic
+	publish(ctx context.Context, topic string, payload []byte) error
+	// subscribe 订阅 topic, 每条消息调用一次 handle, reply 为消息自带的回复地址
+	subscribe(topic string, handle func(payload []byte, reply string)) error
+	close()
+}
+
+// brokerSink 将事件发布到消息队列, 并从 api-topic 接收 API 调用
+type brokerSink struct {
+	name   string
+	conf   *config.BrokerServer
+	client brokerClient
+	api    *apiCaller
+	tokens *accessTokens
+
+	events   chan brokerMessage // 等待发布的事件
+	requests chan brokerMessage // 等待处理的 API 调用
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// brokerMessage 等待发布的事件或等待处理的 API 调用
+type brokerMessage struct {
+	topic   string // 事件发布的 topic, 或 API 调用自带的回复地址
+	payload []byte
+}
+
+const (
+	// brokerPublishTimeout 发布一条消息的超时时间
+	brokerPublishTimeout = time.Second * 5
+	// brokerEventBuffer 等待发布的事件数, 超出时丢弃新的事件
+	brokerEventBuffer = 256
+	// brokerRequestBuffer 等待处理的 API 调用数, 超出时丢弃新的调用
+	brokerRequestBuffer = 256
+	// brokerWorkers 同时处理 API 调用的协程数
+	brokerWorkers = 8
+)
+
+var topicField = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// renderTopic 将 template 中的 {字段} 替换为 j 中对应字段的值, 不存在或为空的字段替换为 _
+func renderTopic(template string, j gjson.Result) string {
+	return topicField.ReplaceAllStringFunc(template, func(s string) string {
+		v := j.Get(s[1 : len(s)-1]).String()
+		if v == "" {
+			return "_"
+		}
+		return v
+	})
+}
+
+// redactURL 隐藏地址中的密码, 用于日志输出
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	return u.Redacted()
+}
+
+// runBroker 使用 dial 连接消息队列并启动通信服务, 返回的函数用于停止该服务, 连接失败时返回 nil
+//
+// bot 为 nil 时发布所有已登录账号的事件, API 调用按请求中的 self_id 路由.
+func runBroker(name string, bot *coolq.CQBot, conf *config.BrokerServer, dial func(conf *config.BrokerServer) (brokerClient, error)) (stop func()) {
+	if conf.Disabled {
+		return func() {}
+	}
+	if conf.Topic == "" && conf.APITopic == "" {
+		log.Warnf("%v 未设置 topic 与 api-topic, 已跳过.", name)
+		return func() {}
+	}
+	addr := redactURL(conf.URL)
+	client, err := dial(conf)
+	if err != nil {
+		log.Warnf("连接到 %v 服务器 %v 失败: %v", name, addr, err)
+		return nil
+	}
+	s := newBrokerSink(name, bot, conf, client)
+	var removes []func()
+	if conf.Topic != "" {
+		s.wg.Add(1)
+		go s.publishEvents()
+		for _, b := range botsOf(bot) {
+			removes = append(removes, b.OnEventPush(s.onBotPushEvent))
+		}
+	}
+	if conf.APITopic != "" {
+		if conf.ReplyTopic == "" {
+			log.Warnf("%v 未设置 reply-topic, API 调用结果仅会发送到请求自带的回复地址.", name)
+		}
+		s.wg.Add(brokerWorkers)
+		for i := 0; i < brokerWorkers; i++ {
+			go s.handleRequests()
+		}
+		if err = client.subscribe(conf.APITopic, s.onRequest); err != nil {
+			log.Warnf("订阅 %v API topic %v 失败: %v", name, conf.APITopic, err)
+		}
+	}
+	log.Infof("%v 通信服务已启动: %v", name, addr)
+	return func() {
+		for _, remove := range removes {
+			remove()
+		}
+		close(s.stop)
+		s.wg.Wait()
+		client.close()
+		log.Infof("%v 通信服务已停止: %v", name, addr)
+	}
+}
+
+func newBrokerSink(name string, bot *coolq.CQBot, conf *config.BrokerServer, client brokerClient) *brokerSink {
+	s := &brokerSink{
+		name:     name,
+		conf:     conf,
+		client:   client,
+		api:      newAPICaller(bot),
+		tokens:   newAccessTokens(&conf.MiddleWares),
+		events:   make(chan brokerMessage, brokerEventBuffer),
+		requests: make(chan brokerMessage, brokerRequestBuffer),
+		stop:     make(chan struct{}),
+	}
+	if conf.RateLimit.Enabled {
+		s.api.use(rateLimit(conf.RateLimit.Frequency, conf.RateLimit.Bucket))
+	}
+	addFilter(conf.Filter)
+	return s
+}
+
+func (s *brokerSink) onBotPushEvent(e *coolq.Event) {
+	body := e.JSONBytes()
+	j := gjson.ParseBytes(body)
+	if filter := findFilter(s.conf.Filter); filter != nil && !filter.Eval(j) {
+		log.Debugf("上报Event %s 到 %v 时被过滤.", body, s.name)
+		return
+	}
+	// JSONBytes 返回的缓冲区在推送后可能被复用, 需要复制
+	msg := brokerMessage{topic: renderTopic(s.conf.Topic, j), payload: append([]byte(nil), body...)}
+	select {
+	case s.events <- msg:
+	case <-s.stop:
+	default:
+		log.Warnf("%v 发布事件过慢, 已丢弃事件: %s", s.name, body)
+	}
+}
+
+// publishEvents 依次发布等待中的事件, 直到服务停止
+func (s *brokerSink) publishEvents() {
+	defer s.wg.Done()
+	for {
+		select {
+		case msg := <-s.events:
+			s.publish(msg.topic, msg.payload)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *brokerSink) publish(topic string, payload []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), brokerPublishTimeout)
+	defer cancel()
+	if err := s.client.publish(ctx, topic, payload); err != nil {
+		log.Warnf("上报Event数据 %s 到 %v %v 失败: %v", payload, s.name, topic, err)
+		return
+	}
+	log.Debugf("上报Event数据 %s 到 %v %v", payload, s.name, topic)
+}
+
+// onRequest 将 API 调用加入等待队列, 由消息队列客户端的接收协程调用
+func (s *brokerSink) onRequest(payload []byte, reply string) {
+	select {
+	case s.requests <- brokerMessage{topic: reply, payload: payload}:
+	case <-s.stop:
+	default:
+		log.Warnf("%v 待处理的 API 调用过多, 已丢弃调用: %s", s.name, payload)
+	}
+}
+
+// handleRequests 依次处理等待中的 API 调用, 直到服务停止
+func (s *brokerSink) handleRequests() {
+	defer s.wg.Done()
+	for {
+		select {
+		case msg := <-s.requests:
+			s.handleRequest(msg.payload, msg.topic)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// handleRequest 处理一个 API 调用, 格式与 WebSocket 相同, 结果发布到 reply 或 reply-topic
+//
+// 设置了访问令牌时, 请求需要在 access_token 字段中携带令牌, 其权限范围将应用于该调用.
+func (s *brokerSink) handleRequest(payload []byte, reply string) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Errorf("处置%v API调用时发生无法恢复的异常：%v\n%s", s.name, err, debug.Stack())
+		}
+	}()
+	j := gjson.ParseBytes(payload)
+	action := j.Get("action").Str
+	fields := log.Fields{"request_id": newRandomID()}
+	if echo := j.Get("echo"); echo.Exists() {
+		fields["echo"] = echo.Value()
+	}
+	var ret coolq.MSG
+	switch status, scope := s.tokens.verify(j.Get("access_token").Str); status {
+	case http.StatusUnauthorized:
+		log.Warnf("已拒绝 %v 的 API 调用: 缺少 access_token", s.name)
+		ret = coolq.Failed(401, "UNAUTHORIZED", "缺少 access_token")
+	case http.StatusForbidden:
+		log.Warnf("已拒绝 %v 的 API 调用: access_token 错误", s.name)
+		ret = coolq.Failed(403, "FORBIDDEN", "access_token 错误")
+	default:
+		api := s.api.withScope(scope).withSelfID(j.Get("self_id").Int()).withLog(fields)
+		api.logger().Debugf("%v接收到API调用: %v 参数: %v", s.name, action, j.Get("params").Raw)
+		ret = api.callAPI(action, j.Get("params"))
+	}
+	if echo := j.Get("echo"); echo.Exists() {
+		ret["echo"] = echo.Value()
+	}
+	if reply == "" {
+		if s.conf.ReplyTopic == "" {
+			return
+		}
+		reply = renderTopic(s.conf.ReplyTopic, j)
+	}
+	data, err := json.Marshal(ret)
+	if err != nil {
+		log.Warnf("编码 API 调用结果失败: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), brokerPublishTimeout)
+	defer cancel()
+	if err = s.client.publish(ctx, reply, data); err != nil {
+		log.Warnf("发送 API 调用结果到 %v %v 失败: %v", s.name, reply, err)
+	}
+}
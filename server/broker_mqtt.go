@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// mqttBroker 使用 MQTT topic 发布事件, 断开后自动重连并恢复订阅
+type mqttBroker struct {
+	client mqtt.Client
+	qos    byte
+
+	lock sync.Mutex
+	subs map[string]mqtt.MessageHandler
+}
+
+func dialMQTT(conf *config.BrokerServer) (brokerClient, error) {
+	m := &mqttBroker{qos: conf.QoS, subs: make(map[string]mqtt.MessageHandler)}
+	clientID := conf.ClientID
+	if clientID == "" {
+		clientID = "go-cqhttp-" + newRandomID()[:8]
+	}
+	opts := mqtt.NewClientOptions().
+		AddBroker(conf.URL).
+		SetClientID(clientID).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetOnConnectHandler(m.onConnect).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			log.Warnf("与 MQTT 服务器的连接已断开: %v, 将自动重连.", err)
+		})
+	m.client = mqtt.NewClient(opts)
+	// 启用 ConnectRetry 后首次连接在后台重试, 此处不等待连接完成
+	m.client.Connect()
+	return m, nil
+}
+
+// onConnect 每次连接成功后重新订阅
+func (m *mqttBroker) onConnect(c mqtt.Client) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for topic, handler := range m.subs {
+		if t := c.Subscribe(topic, m.qos, handler); t.Wait() && t.Error() != nil {
+			log.Warnf("订阅 MQTT topic %v 失败: %v", topic, t.Error())
+		}
+	}
+}
+
+func (m *mqttBroker) publish(ctx context.Context, topic string, payload []byte) error {
+	t := m.client.Publish(topic, m.qos, false, payload)
+	timeout := brokerPublishTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	if !t.WaitTimeout(timeout) {
+		return context.DeadlineExceeded
+	}
+	return t.Error()
+}
+
+// subscribe 记录订阅, 已连接时立即订阅, 否则在连接成功后订阅
+func (m *mqttBroker) subscribe(topic string, handle func(payload []byte, reply string)) error {
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		handle(msg.Payload(), "")
+	}
+	m.lock.Lock()
+	m.subs[topic] = handler
+	m.lock.Unlock()
+	if !m.client.IsConnectionOpen() {
+		return nil
+	}
+	t := m.client.Subscribe(topic, m.qos, handler)
+	t.Wait()
+	return t.Error()
+}
+
+func (m *mqttBroker) close() {
+	m.client.Disconnect(250)
+}
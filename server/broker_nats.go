@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// natsBroker 使用 NATS subject 发布事件, 断开后自动重连
+type natsBroker struct {
+	conn *nats.Conn
+}
+
+func dialNATS(conf *config.BrokerServer) (brokerClient, error) {
+	conn, err := nats.Connect(conf.URL,
+		nats.Name("go-cqhttp"),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &natsBroker{conn: conn}, nil
+}
+
+// publish 将消息写入发送缓冲区, 断开期间的消息在重连后发送
+func (n *natsBroker) publish(_ context.Context, topic string, payload []byte) error {
+	return n.conn.Publish(topic, payload)
+}
+
+func (n *natsBroker) subscribe(topic string, handle func(payload []byte, reply string)) error {
+	_, err := n.conn.Subscribe(topic, func(m *nats.Msg) {
+		handle(m.Data, m.Reply)
+	})
+	return err
+}
+
+func (n *natsBroker) close() {
+	_ = n.conn.Drain()
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// redisBroker 使用 Redis Streams 发布事件, 消息内容保存在 data 字段中
+type redisBroker struct {
+	client *redis.Client
+	maxLen int64
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func dialRedis(conf *config.BrokerServer) (brokerClient, error) {
+	opt, err := redis.ParseURL(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &redisBroker{client: redis.NewClient(opt), maxLen: conf.MaxLen, ctx: ctx, cancel: cancel}, nil
+}
+
+func (r *redisBroker) publish(ctx context.Context, topic string, payload []byte) error {
+	return r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: topic,
+		MaxLen: r.maxLen,
+		Approx: r.maxLen > 0,
+		Values: []interface{}{"data", payload},
+	}).Err()
+}
+
+// subscribe 从 topic 读取订阅后新增的消息, Redis Streams 没有回复地址, reply 始终为空
+func (r *redisBroker) subscribe(topic string, handle func(payload []byte, reply string)) error {
+	// 从订阅时最后一条消息之后开始读取, 不使用 "$" 以免两次读取之间的消息被跳过
+	last := "0-0"
+	if ms, err := r.client.XRevRangeN(r.ctx, topic, "+", "-", 1).Result(); err != nil {
+		return err
+	} else if len(ms) > 0 {
+		last = ms[0].ID
+	}
+	go func() {
+		for {
+			streams, err := r.client.XRead(r.ctx, &redis.XReadArgs{
+				Streams: []string{topic, last},
+				Count:   100,
+				Block:   time.Second * 5,
+			}).Result()
+			if r.ctx.Err() != nil {
+				return
+			}
+			if err != nil && err != redis.Nil {
+				log.Warnf("读取 Redis Stream %v 失败: %v, 将在 3 秒后重试.", topic, err)
+				time.Sleep(time.Second * 3)
+				continue
+			}
+			for _, stream := range streams {
+				for _, m := range stream.Messages {
+					last = m.ID
+					if data, ok := m.Values["data"].(string); ok {
+						handle([]byte(data), "")
+					}
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *redisBroker) close() {
+	r.cancel()
+	_ = r.client.Close()
+}
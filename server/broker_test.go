@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// fakeBroker 记录发布的消息的消息队列客户端
+type fakeBroker struct {
+	published chan brokerMessage
+	handle    func(payload []byte, reply string)
+}
+
+func newFakeBroker() *fakeBroker {
+	return &fakeBroker{published: make(chan brokerMessage, 16)}
+}
+
+func (f *fakeBroker) publish(_ context.Context, topic string, payload []byte) error {
+	f.published <- brokerMessage{topic: topic, payload: payload}
+	return nil
+}
+
+func (f *fakeBroker) subscribe(_ string, handle func(payload []byte, reply string)) error {
+	f.handle = handle
+	return nil
+}
+
+func (f *fakeBroker) close() {}
+
+func (f *fakeBroker) next(t *testing.T) brokerMessage {
+	select {
+	case msg := <-f.published:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("no message published")
+		return brokerMessage{}
+	}
+}
+
+func TestRenderTopic(t *testing.T) {
+	event := gjson.Parse(`{"self_id":10001,"post_type":"message","group_id":123,"sender":{"user_id":456},"sub_type":""}`)
+	var tests = [...]struct {
+		template string
+		expected string
+	}{
+		{"qq.events", "qq.events"},
+		{"qq.{self_id}.{post_type}", "qq.10001.message"},
+		{"qq/{self_id}/{group_id}/{sender.user_id}", "qq/10001/123/456"},
+		{"qq.{self_id}.{user_id}", "qq.10001._"},
+		{"qq.{sub_type}", "qq._"},
+		{"qq.{self_id", "qq.{self_id"},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			assert.Equal(t, tests[i].expected, renderTopic(tests[i].template, event))
+		})
+	}
+}
+
+func TestBrokerRequest(t *testing.T) {
+	client := newFakeBroker()
+	conf := &config.BrokerServer{Topic: "qq.{self_id}", APITopic: "qq.api", ReplyTopic: "qq.reply.{self_id}"}
+	conf.AccessTokens = []config.AccessToken{{Token: "a"}, {Token: "b", AllowActions: []string{"get_status"}}}
+	stop := runBroker("test", nil, conf, func(*config.BrokerServer) (brokerClient, error) { return client, nil })
+	defer stop()
+
+	var tests = [...]struct {
+		payload string
+		reply   string
+		topic   string
+		msg     string
+	}{
+		{`{"action":"get_status","echo":"1"}`, "", "qq.reply._", "UNAUTHORIZED"},
+		{`{"action":"get_status","echo":"2","access_token":"c"}`, "", "qq.reply._", "FORBIDDEN"},
+		{`{"action":"get_login_info","echo":"3","access_token":"b"}`, "", "qq.reply._", "ACTION_FORBIDDEN"},
+		{`{"action":"get_status","echo":"4","access_token":"b","self_id":1}`, "", "qq.reply.1", "BOT_NOT_FOUND"},
+		{`{"action":"get_status","echo":"5","access_token":"a"}`, "inbox.1", "inbox.1", "BOT_NOT_FOUND"},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			client.handle([]byte(tests[i].payload), tests[i].reply)
+			msg := client.next(t)
+			ret := gjson.ParseBytes(msg.payload)
+			assert.Equal(t, tests[i].topic, msg.topic)
+			assert.Equal(t, tests[i].msg, ret.Get("msg").String())
+			assert.Equal(t, gjson.Get(tests[i].payload, "echo").String(), ret.Get("echo").String())
+		})
+	}
+}
+
+func TestBrokerEvent(t *testing.T) {
+	client := newFakeBroker()
+	s := newBrokerSink("test", nil, &config.BrokerServer{Topic: "qq.{self_id}.{post_type}"}, client)
+	s.wg.Add(1)
+	go s.publishEvents()
+	s.onBotPushEvent(&coolq.Event{RawMsg: coolq.MSG{"self_id": 1, "post_type": "notice"}})
+	msg := client.next(t)
+	assert.Equal(t, "qq.1.notice", msg.topic)
+	assert.Equal(t, int64(1), gjson.GetBytes(msg.payload, "self_id").Int())
+	close(s.stop)
+	s.wg.Wait()
+
+	// 发布过慢时丢弃新的事件, 不阻塞事件推送
+	s = newBrokerSink("test", nil, &config.BrokerServer{Topic: "qq"}, client)
+	for i := 0; i < brokerEventBuffer+1; i++ {
+		s.onBotPushEvent(&coolq.Event{RawMsg: coolq.MSG{"self_id": i}})
+	}
+	assert.Len(t, s.events, brokerEventBuffer)
+}
@@ -0,0 +1,313 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/server/pb"
+)
+
+// grpcServer gRPC 通信服务, 协议定义见 pb/onebot.proto
+//
+// 除 SubscribeEvents 外的 RPC 均转换为同名的 API 调用, 因此 pb.OneBotServer 中的其他方法不会被调用.
+type grpcServer struct {
+	pb.UnimplementedOneBotServer
+
+	bot    *coolq.CQBot
+	api    *apiCaller
+	tokens *accessTokens
+	filter string
+}
+
+// grpcEventBuffer 每个订阅等待发送的事件数, 超出时丢弃新的事件
+const grpcEventBuffer = 256
+
+// RunGRPCServer 运行一个 gRPC 服务器, 返回的函数用于停止该服务器, 启动失败时返回 nil
+//
+// b 为 nil 时推送所有已登录账号的事件, API 调用按 x-self-id 元数据路由.
+func RunGRPCServer(b *coolq.CQBot, conf *config.GRPCServer) (stop func()) {
+	if conf.Disabled {
+		return func() {}
+	}
+	s := &grpcServer{
+		bot:    b,
+		api:    newAPICaller(b),
+		tokens: newAccessTokens(&conf.MiddleWares),
+		filter: conf.Filter,
+	}
+	if conf.RateLimit.Enabled {
+		s.api.use(rateLimit(conf.RateLimit.Frequency, conf.RateLimit.Bucket))
+	}
+	addFilter(s.filter)
+	desc, err := s.serviceDesc()
+	if err != nil {
+		log.Errorf("启动 gRPC 服务器失败: %v", err)
+		return nil
+	}
+	server := grpc.NewServer()
+	server.RegisterService(desc, s)
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	lis, err := listen("gRPC 服务", addr)
+	if err != nil {
+		return nil
+	}
+	go func() {
+		log.Infof("gRPC 服务器已启动: %v", addr)
+		if err := server.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			log.Errorf("gRPC 服务器 %v 出现错误: %v", addr, err)
+		}
+	}()
+	return func() {
+		server.Stop()
+		log.Infof("gRPC 服务器已停止: %v", addr)
+	}
+}
+
+// serviceDesc 返回以 API 调用实现所有一元 RPC 的服务描述
+func (s *grpcServer) serviceDesc() (*grpc.ServiceDesc, error) {
+	service := pb.File_onebot_proto.Services().ByName("OneBot")
+	desc := pb.OneBot_ServiceDesc
+	desc.Methods = make([]grpc.MethodDesc, 0, len(pb.OneBot_ServiceDesc.Methods))
+	for _, m := range pb.OneBot_ServiceDesc.Methods {
+		md := service.Methods().ByName(protoreflect.Name(m.MethodName))
+		action, ok := grpcAction(m.MethodName)
+		if md == nil || !ok {
+			return nil, errors.Errorf("RPC %v 没有对应的 API", m.MethodName)
+		}
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler:    s.methodHandler(action, md),
+		})
+	}
+	return &desc, nil
+}
+
+// grpcAction 返回 RPC 方法对应的 API 名称, 如 GetLoginInfo -> get_login_info
+//
+// 依次查找无前缀, "_" 前缀与 "." 前缀的 API, 以兼容 _get_vip_info, .get_word_slices 等扩展 API.
+func grpcAction(method string) (string, bool) {
+	var sb strings.Builder
+	for i, r := range method {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	name := sb.String()
+	for _, prefix := range []string{"", "_", "."} {
+		if _, ok := API[prefix+name]; ok {
+			return prefix + name, true
+		}
+	}
+	return "", false
+}
+
+// methodHandler 返回调用 action 的 RPC 处理函数, 类型与生成代码中的 _OneBot_*_Handler 相同
+func (s *grpcServer) methodHandler(action string, md protoreflect.MethodDescriptor) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(_ interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in, err := newProtoMessage(md.Input())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err = dec(in); err != nil {
+			return nil, err
+		}
+		call := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.call(ctx, action, req.(proto.Message), md.Output())
+		}
+		if interceptor == nil {
+			return call(ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{Server: s, FullMethod: fmt.Sprintf("/%v/%v", md.Parent().FullName(), md.Name())}
+		return interceptor(ctx, in, info, call)
+	}
+}
+
+// call 以请求消息的 JSON 作为参数调用 action, 并将响应中的 data 转换为 out 类型的消息
+func (s *grpcServer) call(ctx context.Context, action string, in proto.Message, out protoreflect.MessageDescriptor) (proto.Message, error) {
+	scope, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	params, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	requestID := firstMetadata(md, "x-request-id")
+	if requestID == "" {
+		requestID = newRandomID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", requestID))
+	api := s.api.withScope(scope).withSelfID(parseSelfID(firstMetadata(md, "x-self-id"))).
+		withLog(log.Fields{"request_id": requestID})
+	api.logger().Debugf("gRPC接收到API调用: %v 参数: %s", action, params)
+	ret := api.callAPI(action, gjson.ParseBytes(params))
+	if ret["status"] == "failed" {
+		_ = grpc.SetTrailer(ctx, metadata.Pairs("retcode", fmt.Sprint(ret["retcode"])))
+		return nil, grpcError(ret)
+	}
+	resp, err := newProtoMessage(out)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err = decodeData(ret["data"], resp); err != nil {
+		api.logger().Warnf("转换 API %v 的响应失败: %v", action, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// SubscribeEvents ref: pb.OneBotServer
+func (s *grpcServer) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.OneBot_SubscribeEventsServer) error {
+	if _, err := s.authorize(stream.Context()); err != nil {
+		return err
+	}
+	postTypes := make(map[string]struct{}, len(req.PostTypes))
+	for _, t := range req.PostTypes {
+		postTypes[t] = struct{}{}
+	}
+	events := make(chan *pb.Event, grpcEventBuffer)
+	var removes []func()
+	for _, bot := range botsOf(s.bot) {
+		if req.SelfId != 0 && bot.Client.Uin != req.SelfId {
+			continue
+		}
+		removes = append(removes, bot.OnEventPush(func(e *coolq.Event) {
+			body := e.JSONBytes()
+			j := gjson.ParseBytes(body)
+			postType := j.Get("post_type").Str
+			if _, ok := postTypes[postType]; len(postTypes) > 0 && !ok {
+				return
+			}
+			if filter := findFilter(s.filter); filter != nil && !filter.Eval(j) {
+				log.Debugf("上报Event %s 到 gRPC客户端 时被过滤.", body)
+				return
+			}
+			ev := &pb.Event{
+				SelfId:     j.Get("self_id").Int(),
+				Time:       j.Get("time").Int(),
+				PostType:   postType,
+				DetailType: j.Get(postType + "_type").Str,
+				SubType:    j.Get("sub_type").Str,
+				Json:       string(body),
+			}
+			select {
+			case events <- ev:
+			default:
+				log.Warnf("gRPC客户端接收事件过慢, 已丢弃事件: %s", body)
+			}
+		}))
+	}
+	defer func() {
+		for _, remove := range removes {
+			remove()
+		}
+	}()
+	if len(removes) == 0 {
+		return status.Error(codes.NotFound, "账号不存在或尚未登录")
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev := <-events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// authorize 校验 authorization 或 access_token 元数据中的访问令牌
+func (s *grpcServer) authorize(ctx context.Context) (*tokenScope, error) {
+	if s.tokens == nil {
+		return nil, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := firstMetadata(md, "authorization")
+	if auth == "" {
+		auth = firstMetadata(md, "access_token")
+	} else {
+		auth = bearerToken(auth)
+	}
+	code, scope := s.tokens.verify(auth)
+	switch code {
+	case http.StatusUnauthorized:
+		return nil, status.Error(codes.Unauthenticated, "缺少访问令牌")
+	case http.StatusForbidden:
+		return nil, status.Error(codes.PermissionDenied, "访问令牌错误")
+	}
+	return scope, nil
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// grpcError 将失败的 API 响应转换为 gRPC 错误
+func grpcError(ret coolq.MSG) error {
+	code := codes.Unknown
+	switch ret["retcode"] {
+	case 403:
+		code = codes.PermissionDenied
+	case 404:
+		code = codes.NotFound
+	case 503:
+		code = codes.Unavailable
+	}
+	return status.Errorf(code, "%v: %v", ret["msg"], ret["wording"])
+}
+
+func newProtoMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// decodeData 将 API 响应中的 data 转换为 protobuf 消息
+//
+// 响应消息只有一个名为 data 的字段, 或 data 为数组且响应消息只有一个 repeated 字段时,
+// data 将作为该字段的值.
+func decodeData(data interface{}, out proto.Message) error {
+	if data == nil {
+		return nil
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	fields := out.ProtoReflect().Descriptor().Fields()
+	if fields.Len() == 1 {
+		f := fields.Get(0)
+		if f.Name() == "data" || (f.IsList() && gjson.ParseBytes(b).IsArray()) {
+			b = []byte(fmt.Sprintf(`{"%s":%s}`, f.Name(), b))
+		}
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, out)
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/server/pb"
+)
+
+func TestGRPCAction(t *testing.T) {
+	var tests = [...]struct {
+		method   string
+		expected string
+		ok       bool
+	}{
+		{"GetLoginInfo", "get_login_info", true},
+		{"QidianGetAccountInfo", "qidian_get_account_info", true},
+		{"GetVipInfo", "_get_vip_info", true},
+		{"GetWordSlices", ".get_word_slices", true},
+		{"HandleQuickOperation", ".handle_quick_operation", true},
+		{"NotExists", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			action, ok := grpcAction(tt.method)
+			assert.Equal(t, tt.expected, action)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+	// 每个 RPC 都应有对应的 API
+	_, err := (&grpcServer{}).serviceDesc()
+	assert.NoError(t, err)
+}
+
+func TestDecodeData(t *testing.T) {
+	var tests = [...]struct {
+		name     string
+		data     interface{}
+		out      proto.Message
+		expected proto.Message
+	}{
+		{"nil", nil, &pb.GetLoginInfoResponse{}, &pb.GetLoginInfoResponse{}},
+		{"object", coolq.MSG{"user_id": 1, "nickname": "a", "unknown": 1}, &pb.GetLoginInfoResponse{}, &pb.GetLoginInfoResponse{UserId: 1, Nickname: "a"}},
+		{"list", []coolq.MSG{{"group_id": 1, "group_name": "g"}}, &pb.GetGroupListResponse{}, &pb.GetGroupListResponse{Groups: []*pb.GroupInfo{{GroupId: 1, GroupName: "g"}}}},
+		{"repeated field", coolq.MSG{"slices": []string{"a", "b"}}, &pb.GetWordSlicesResponse{}, &pb.GetWordSlicesResponse{Slices: []string{"a", "b"}}},
+		{"single field", coolq.MSG{"message_id": 1}, &pb.SendMsgResponse{}, &pb.SendMsgResponse{MessageId: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, decodeData(tt.data, tt.out))
+			assert.True(t, proto.Equal(tt.expected, tt.out), "got %v", tt.out)
+		})
+	}
+
+	out := &pb.DataResponse{}
+	assert.NoError(t, decodeData(coolq.MSG{"a": []int{1}}, out))
+	assert.Equal(t, float64(1), out.GetData().GetStructValue().AsMap()["a"].([]interface{})[0])
+	assert.Error(t, decodeData(coolq.MSG{"user_id": "a"}, &pb.GetLoginInfoResponse{}))
+}
+
+func TestGRPCError(t *testing.T) {
+	var tests = [...]struct {
+		retcode  int
+		expected codes.Code
+	}{
+		{100, codes.Unknown},
+		{403, codes.PermissionDenied},
+		{404, codes.NotFound},
+		{503, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		err := grpcError(coolq.Failed(tt.retcode, "MSG", "wording"))
+		assert.Equal(t, tt.expected, status.Code(err))
+		assert.Equal(t, "MSG: wording", status.Convert(err).Message())
+	}
+}
@@ -1,15 +1,12 @@
 package server
 
 import (
+	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha1"
-	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -17,28 +14,37 @@ import (
 	"github.com/guonaihong/gout"
 	"github.com/guonaihong/gout/dataflow"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
 
 	"github.com/Mrs4s/go-cqhttp/coolq"
 	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
 )
 
 type httpServer struct {
-	HTTP        *http.Server
-	api         *apiCaller
-	accessToken string
+	HTTP     *http.Server
+	api      *apiCaller
+	tokens   *accessTokens
+	verifier *signatureVerifier
 }
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
 // HTTPClient 反向HTTP上报客户端
 type HTTPClient struct {
-	bot     *coolq.CQBot
-	secret  string
-	addr    string
-	filter  string
-	timeout int32
+	bot       *coolq.CQBot
+	secret    string
+	algorithm string
+	timestamp bool
+	addr      string
+	filter    string
+	timeout   int32
+	v12       bool
+
+	queueConf config.HTTPQueue
+	queue     *eventQueue
 }
 
 type httpCtx struct {
@@ -67,16 +73,21 @@ func (h *httpCtx) Get(s string) gjson.Result {
 
 func (s *httpServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	var ctx httpCtx
+	var body []byte
 	contentType := request.Header.Get("Content-Type")
 	switch request.Method {
 	case http.MethodPost:
-		if strings.Contains(contentType, "application/json") {
-			body, err := io.ReadAll(request.Body)
+		if s.verifier != nil || strings.Contains(contentType, "application/json") {
+			var err error
+			body, err = io.ReadAll(request.Body)
 			if err != nil {
 				log.Warnf("获取请求 %v 的Body时出现错误: %v", request.RequestURI, err)
 				writer.WriteHeader(http.StatusBadRequest)
 				return
 			}
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		if strings.Contains(contentType, "application/json") {
 			if !gjson.ValidBytes(body) {
 				log.Warnf("已拒绝客户端 %v 的请求: 非法Json", request.RemoteAddr)
 				writer.WriteHeader(http.StatusBadRequest)
@@ -95,160 +106,236 @@ func (s *httpServer) ServeHTTP(writer http.ResponseWriter, request *http.Request
 		fallthrough
 	case http.MethodGet:
 		ctx.query = request.URL.Query()
+		if request.Method == http.MethodGet {
+			body = []byte(request.URL.RawQuery)
+		}
 
 	default:
 		log.Warnf("已拒绝客户端 %v 的请求: 方法错误", request.RemoteAddr)
 		writer.WriteHeader(http.StatusNotFound)
 		return
 	}
-	if status := checkAuth(request, s.accessToken); status != http.StatusOK {
+	status, scope := checkAuth(request, s.tokens)
+	if status != http.StatusOK {
 		writer.WriteHeader(status)
 		return
 	}
+	if s.verifier != nil {
+		if status := s.verifier.verify(request, body); status != http.StatusOK {
+			log.Warnf("已拒绝客户端 %v 的请求: 签名验证失败", request.RemoteAddr)
+			writer.WriteHeader(status)
+			return
+		}
+	}
 
 	action := strings.TrimPrefix(request.URL.Path, "/")
-	action = strings.TrimSuffix(action, "_async")
-	log.Debugf("HTTPServer接收到API调用: %v", action)
-	ret := s.api.callAPI(action, &ctx)
+	requestID := request.Header.Get("X-Request-ID")
+	if requestID == "" {
+		requestID = newRandomID()
+	}
+	api := s.api.withScope(scope).withSelfID(parseSelfID(request.Header.Get("X-Self-ID"))).
+		withLog(log.Fields{"request_id": requestID})
+	api.logger().Debugf("HTTPServer接收到API调用: %v", action)
+	ret := api.callAPI(action, &ctx)
 
+	writer.Header().Set("X-Request-ID", requestID)
 	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
 	writer.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(writer).Encode(ret)
 }
 
-func checkAuth(req *http.Request, token string) int {
-	if token == "" { // quick path
-		return http.StatusOK
+// RunHTTPServerAndClients 启动HTTP服务器与HTTP上报客户端, 返回的函数用于停止它们
+//
+// HTTP服务器启动失败时不启动上报客户端并返回 nil.
+//
+// bot 为 nil 时服务于所有已登录的账号, API 调用按 self_id 参数或 X-Self-ID 请求头路由.
+func RunHTTPServerAndClients(bot *coolq.CQBot, conf *config.HTTPServer) (stop func()) {
+	if conf.Disabled {
+		return func() {}
 	}
-
-	auth := req.Header.Get("Authorization")
-	if auth == "" {
-		auth = req.URL.Query().Get("access_token")
-	} else {
-		authN := strings.SplitN(auth, " ", 2)
-		if len(authN) == 2 {
-			auth = authN[1]
+	for _, c := range conf.Post {
+		if err := checkAlgorithm(c.Algorithm); c.URL != "" && c.Secret != "" && err != nil {
+			log.Errorf("HTTP 上报地址 %v 的签名配置错误, HTTP 服务将不会启动: %v", c.URL, err)
+			return nil
 		}
 	}
-
-	switch auth {
-	case token:
-		return http.StatusOK
-	case "":
-		return http.StatusUnauthorized
-	default:
-		return http.StatusForbidden
+	var stops []func()
+	if conf.Host != "" && conf.Port != 0 {
+		stop := runHTTPServer(bot, conf)
+		if stop == nil {
+			return nil
+		}
+		stops = append(stops, stop)
+	}
+	for _, c := range conf.Post {
+		if c.URL == "" {
+			continue
+		}
+		for _, b := range botsOf(bot) {
+			stops = append(stops, HTTPClient{
+				bot:       b,
+				secret:    c.Secret,
+				algorithm: c.Algorithm,
+				timestamp: c.Timestamp,
+				addr:      c.URL,
+				filter:    conf.Filter,
+				timeout:   conf.Timeout,
+				v12:       isV12(conf.OneBotVersion),
+				queueConf: conf.Queue,
+			}.Run())
+		}
+	}
+	return func() {
+		for _, f := range stops {
+			f()
+		}
 	}
 }
 
-// RunHTTPServerAndClients 启动HTTP服务器与HTTP上报客户端
-func RunHTTPServerAndClients(bot *coolq.CQBot, conf *config.HTTPServer) {
-	if conf.Disabled {
-		return
+// runHTTPServer 启动HTTP服务器, 签名配置错误, 读取 TLS 证书或监听失败时不启动并返回 nil
+func runHTTPServer(bot *coolq.CQBot, conf *config.HTTPServer) (stop func()) {
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	verifier, err := newSignatureVerifier(&conf.Sign)
+	if err != nil {
+		log.Errorf("HTTP 服务器 %v 的签名配置错误, 服务器将不会启动: %v", addr, err)
+		return nil
 	}
-	var (
-		s    = new(httpServer)
-		addr string
-	)
-	s.accessToken = conf.AccessToken
-	if conf.Host == "" || conf.Port == 0 {
-		goto client
+	s := &httpServer{
+		api:      newAPICaller(bot),
+		tokens:   newAccessTokens(&conf.MiddleWares),
+		verifier: verifier,
 	}
-	addr = fmt.Sprintf("%s:%d", conf.Host, conf.Port)
-	s.api = newAPICaller(bot)
+	s.api.v12 = isV12(conf.OneBotVersion)
 	if conf.RateLimit.Enabled {
 		s.api.use(rateLimit(conf.RateLimit.Frequency, conf.RateLimit.Bucket))
 	}
-
+	tlsConf, err := serverTLSConfig(&conf.TLS, s.tokens == nil)
+	if err != nil {
+		log.Errorf("读取 HTTP 服务器 %v 的 TLS 证书失败, 服务器将不会启动: %v", addr, err)
+		return nil
+	}
+	s.HTTP = &http.Server{
+		Addr:      addr,
+		Handler:   s,
+		TLSConfig: tlsConf,
+	}
+	lis, err := listen("HTTP 服务", addr)
+	if err != nil {
+		return nil
+	}
 	go func() {
 		log.Infof("CQ HTTP 服务器已启动: %v", addr)
-		s.HTTP = &http.Server{
-			Addr:    addr,
-			Handler: s,
-		}
-		if err := s.HTTP.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Error(err)
-			log.Infof("HTTP 服务启动失败, 请检查端口是否被占用.")
-			log.Warnf("将在五秒后退出.")
-			time.Sleep(time.Second * 5)
-			os.Exit(1)
+		if err := serve(s.HTTP, lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("HTTP 服务器 %v 出现错误: %v", addr, err)
 		}
 	}()
-client:
-	for _, c := range conf.Post {
-		if c.URL != "" {
-			go HTTPClient{
-				bot:     bot,
-				secret:  c.Secret,
-				addr:    c.URL,
-				filter:  conf.Filter,
-				timeout: conf.Timeout,
-			}.Run()
-		}
-	}
+	return s.ShutDown
 }
 
-// Run 运行反向HTTP服务
-func (c HTTPClient) Run() {
+// Run 运行反向HTTP服务, 返回的函数用于停止上报
+func (c HTTPClient) Run() (stop func()) {
 	addFilter(c.filter)
 	if c.timeout < 5 {
 		c.timeout = 5
 	}
-	c.bot.OnEventPush(c.onBotPushEvent)
+	if c.queueConf.Enabled {
+		q, err := openEventQueue(c.bot, c.addr, c.queueConf.MaxSize, time.Second*time.Duration(c.queueConf.MaxBackoff), c.deliver)
+		if err != nil {
+			log.Warnf("打开上报队列失败, 将不使用队列上报: %v", err)
+		} else {
+			c.queue = q
+		}
+	}
+	remove := c.bot.OnEventPush(c.onBotPushEvent)
 	log.Infof("HTTP POST上报器已启动: %v", c.addr)
+	return func() {
+		remove()
+		if c.queue != nil {
+			c.queue.close()
+		}
+		log.Infof("HTTP POST上报器已停止: %v", c.addr)
+	}
 }
 
 func (c *HTTPClient) onBotPushEvent(e *coolq.Event) {
 	var res string
+	body := eventPayload(c.v12, e.JSONBytes())
 	if c.filter != "" {
 		filter := findFilter(c.filter)
-		if filter != nil && !filter.Eval(gjson.Parse(e.JSONString())) {
-			log.Debugf("上报Event %v 到 HTTP 服务器 %s 时被过滤.", c.addr, e.JSONBytes())
+		if filter != nil && !filter.Eval(gjson.ParseBytes(body)) {
+			log.Debugf("上报Event %v 到 HTTP 服务器 %s 时被过滤.", c.addr, body)
 			return
 		}
 	}
+	if c.queue != nil {
+		c.queue.push(body)
+		return
+	}
 
-	err := gout.POST(c.addr).SetJSON(e.JSONBytes()).BindBody(&res).SetHeader(func() gout.H {
-		h := gout.H{
-			"X-Self-ID":  c.bot.Client.Uin,
-			"User-Agent": "CQHttp/4.15.0",
-		}
-		if c.secret != "" {
-			mac := hmac.New(sha1.New, []byte(c.secret))
-			_, err := mac.Write(e.JSONBytes())
-			if err != nil {
-				log.Error(err)
-				return nil
-			}
-			h["X-Signature"] = "sha1=" + hex.EncodeToString(mac.Sum(nil))
-		}
-		return h
-	}()).SetTimeout(time.Second * time.Duration(c.timeout)).F().Retry().Attempt(5).
+	err := gout.POST(c.addr).SetJSON(body).BindBody(&res).SetHeader(c.header(body)).
+		SetTimeout(time.Second * time.Duration(c.timeout)).F().Retry().Attempt(5).
 		WaitTime(time.Millisecond * 500).MaxWaitTime(time.Second * 5).
 		Func(func(con *dataflow.Context) error {
 			if con.Error != nil {
 				log.Warnf("上报Event到 HTTP 服务器 %v 时出现错误: %v 将重试.", c.addr, con.Error)
+				metrics.HTTPPostRetries.Inc(c.addr)
 				return con.Error
 			}
 			return nil
 		}).Do()
 	if err != nil {
-		log.Warnf("上报Event数据 %s 到 %v 失败: %v", e.JSONBytes(), c.addr, err)
+		log.Warnf("上报Event数据 %s 到 %v 失败: %v", body, c.addr, err)
+		metrics.HTTPPostFailures.Inc(c.addr)
 		return
 	}
-	log.Debugf("上报Event数据 %s 到 %v", e.JSONBytes(), c.addr)
-	if gjson.Valid(res) {
+	log.Debugf("上报Event数据 %s 到 %v", body, c.addr)
+	if !c.v12 && gjson.Valid(res) {
 		c.bot.CQHandleQuickOperation(gjson.Parse(e.JSONString()), gjson.Parse(res))
 	}
 }
 
+// deliver 投递上报队列中的事件, 由上报队列调用
+func (c *HTTPClient) deliver(body []byte) error {
+	var (
+		res  string
+		code int
+	)
+	err := gout.POST(c.addr).SetJSON(body).BindBody(&res).Code(&code).SetHeader(c.header(body)).
+		SetTimeout(time.Second * time.Duration(c.timeout)).Do()
+	if err != nil {
+		return err
+	}
+	if code < 200 || code >= 300 { // 上报目标重启期间常见的 502/503, 保留事件稍后重试
+		return errors.Errorf("unexpected status code %v", code)
+	}
+	log.Debugf("上报Event数据 %s 到 %v", body, c.addr)
+	if !c.v12 && gjson.Valid(res) {
+		c.bot.CQHandleQuickOperation(gjson.ParseBytes(body), gjson.Parse(res))
+	}
+	return nil
+}
+
+func (c *HTTPClient) header(body []byte) gout.H {
+	h := gout.H{
+		"X-Self-ID":  c.bot.Client.Uin,
+		"User-Agent": "CQHttp/4.15.0",
+	}
+	if c.v12 {
+		h["X-OneBot-Version"] = "12"
+		h["X-Impl"] = v12Impl
+		h["X-Platform"] = v12Platform
+	}
+	signHeader(h, c.algorithm, c.secret, c.timestamp, body)
+	return h
+}
+
+// ShutDown 停止HTTP服务器, 最多等待 5 秒让正在处理的请求完成
 func (s *httpServer) ShutDown() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := s.HTTP.Shutdown(ctx); err != nil {
-		log.Fatal("http Server Shutdown:", err)
+		log.Warnf("等待 HTTP 服务器 %v 关闭超时: %v", s.HTTP.Addr, err)
+		_ = s.HTTP.Close()
 	}
-	<-ctx.Done()
-	log.Println("timeout of 5 seconds.")
-	log.Println("http Server exiting")
+	log.Infof("CQ HTTP 服务器已停止: %v", s.HTTP.Addr)
 }
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/guonaihong/gout"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// ErrLoginChallengeTimeout 等待登录验证结果超时
+var ErrLoginChallengeTimeout = errors.New("login challenge timeout")
+
+// pendingChallenges 等待提交结果的登录验证, challenge_id -> *loginChallenge
+var pendingChallenges sync.Map
+
+// loginChallenge 一个等待提交结果的登录验证
+type loginChallenge struct {
+	id      string
+	uin     int64
+	subType string
+	data    coolq.MSG
+	created time.Time
+	expire  time.Time
+	answer  chan string
+}
+
+// pendingLoginChallenges 返回所有等待提交结果的登录验证, 按推送时间排序
+func pendingLoginChallenges() []*loginChallenge {
+	var list []*loginChallenge
+	pendingChallenges.Range(func(_, v interface{}) bool {
+		list = append(list, v.(*loginChallenge))
+		return true
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].created.Before(list[j].created) })
+	return list
+}
+
+// LoginBroker 非交互式登录验证代理
+//
+// 将验证码, 滑条, 短信验证码等登录验证以 meta_event(login_challenge)
+// 的形式推送到上报地址, 并等待通过 submit_login_challenge API 提交的结果.
+type LoginBroker struct {
+	Uin     int64
+	posts   []config.HTTPPost
+	timeout time.Duration
+}
+
+// NewLoginBroker 创建登录验证代理
+func NewLoginBroker(uin int64, posts []config.HTTPPost, timeout time.Duration) *LoginBroker {
+	if timeout <= 0 {
+		timeout = time.Minute * 5
+	}
+	if len(posts) == 0 {
+		log.Warn("警告: 登录验证代理已启用, 但未配置任何 HTTP POST 上报地址.")
+	}
+	return &LoginBroker{Uin: uin, posts: posts, timeout: timeout}
+}
+
+// Notify 推送一个无需提交结果的登录验证, 如二维码与设备锁验证链接
+func (b *LoginBroker) Notify(subType string, data coolq.MSG) {
+	b.push(newRandomID(), subType, data, time.Time{})
+}
+
+// Challenge 推送一个登录验证并等待提交结果
+func (b *LoginBroker) Challenge(subType string, data coolq.MSG) (string, error) {
+	id := newRandomID()
+	expire := time.Now().Add(b.timeout)
+	c := &loginChallenge{
+		id:      id,
+		uin:     b.Uin,
+		subType: subType,
+		data:    data,
+		created: time.Now(),
+		expire:  expire,
+		answer:  make(chan string, 1),
+	}
+	pendingChallenges.Store(id, c)
+	defer pendingChallenges.Delete(id)
+
+	b.push(id, subType, data, expire)
+	log.Infof("已推送登录验证 %v (%v), 等待通过 submit_login_challenge 提交结果...", id, subType)
+	select {
+	case answer := <-c.answer:
+		return answer, nil
+	case <-time.After(b.timeout):
+		return "", errors.WithStack(ErrLoginChallengeTimeout)
+	}
+}
+
+func (b *LoginBroker) push(id, subType string, data coolq.MSG, expire time.Time) {
+	m := coolq.MSG{
+		"time":            time.Now().Unix(),
+		"self_id":         b.Uin,
+		"post_type":       "meta_event",
+		"meta_event_type": "login_challenge",
+		"sub_type":        subType,
+		"challenge_id":    id,
+	}
+	if !expire.IsZero() {
+		m["expire"] = expire.Unix()
+	}
+	for k, v := range data {
+		m[k] = v
+	}
+	body, _ := json.Marshal(m)
+	for _, p := range b.posts {
+		if p.URL == "" {
+			continue
+		}
+		h := gout.H{
+			"X-Self-ID":  b.Uin,
+			"User-Agent": "CQHttp/4.15.0",
+		}
+		signHeader(h, p.Algorithm, p.Secret, p.Timestamp, body)
+		err := gout.POST(p.URL).SetJSON(body).SetHeader(h).SetTimeout(time.Second * 10).Do()
+		if err != nil {
+			log.Warnf("推送登录验证到 %v 时出现错误: %v", p.URL, err)
+		}
+	}
+}
+
+// SubmitLoginChallenge 提交登录验证结果, 验证不存在或已过期时返回 false
+func SubmitLoginChallenge(id, answer string) bool {
+	v, ok := pendingChallenges.Load(id)
+	if !ok {
+		return false
+	}
+	select {
+	case v.(*loginChallenge).answer <- answer:
+		return true
+	default:
+		return false
+	}
+}
+
+// newRandomID 返回 16 位十六进制的随机ID
+func newRandomID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RunLoginBrokerServer 在登录完成前启动一个仅提供 submit_login_challenge 的 HTTP 服务器
+//
+// 返回的函数用于在登录完成后关闭该服务器, 以便正常的 HTTP 服务器使用同一端口.
+func RunLoginBrokerServer(conf *config.HTTPServer) (stop func()) {
+	if conf.Disabled || conf.Host == "" || conf.Port == 0 {
+		return func() {}
+	}
+	s := &httpServer{
+		api:    newAPICaller(nil),
+		tokens: newAccessTokens(&conf.MiddleWares),
+	}
+	s.api.use(func(action string, p resultGetter) coolq.MSG {
+		if action != "submit_login_challenge" {
+			return coolq.Failed(503, "BOT_NOT_READY", "Bot 尚未完成登录")
+		}
+		return submitLoginChallenge(nil, p)
+	})
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	s.HTTP = &http.Server{
+		Addr:    addr,
+		Handler: s,
+	}
+	go func() {
+		log.Infof("登录验证 HTTP 服务器已启动: %v", addr)
+		if err := s.HTTP.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Warnf("登录验证 HTTP 服务器启动失败: %v", err)
+		}
+	}()
+	return func() {
+		_ = s.HTTP.Close()
+	}
+}
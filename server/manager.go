@@ -0,0 +1,186 @@
+package server
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// runner 一种可在 servers 中配置的通信服务
+type runner struct {
+	name string                                                 // 日志中显示的名称
+	conf func() interface{}                                     // 创建配置结构体
+	run  func(bot *coolq.CQBot, conf interface{}) (stop func()) // 启动服务, 启动失败时返回 nil
+}
+
+var runners = map[string]runner{
+	"http": {"http", func() interface{} { return new(config.HTTPServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return RunHTTPServerAndClients(bot, c.(*config.HTTPServer))
+	}},
+	"ws": {"正向Websocket", func() interface{} { return new(config.WebsocketServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return RunWebSocketServer(bot, c.(*config.WebsocketServer))
+	}},
+	"ws-reverse": {"反向Websocket", func() interface{} { return new(config.WebsocketReverse) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return RunWebSocketClient(bot, c.(*config.WebsocketReverse))
+	}},
+	"serverless": {"Serverless", func() interface{} { return new(config.ServerlessServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return RunServerlessServer(bot, c.(*config.ServerlessServer))
+	}},
+	"metrics": {"metrics", func() interface{} { return new(config.MetricsServer) }, func(_ *coolq.CQBot, c interface{}) func() {
+		return RunMetricsServer(c.(*config.MetricsServer))
+	}},
+	"grpc": {"gRPC", func() interface{} { return new(config.GRPCServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return RunGRPCServer(bot, c.(*config.GRPCServer))
+	}},
+	"redis": {"Redis", func() interface{} { return new(config.BrokerServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return runBroker("Redis", bot, c.(*config.BrokerServer), dialRedis)
+	}},
+	"nats": {"NATS", func() interface{} { return new(config.BrokerServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return runBroker("NATS", bot, c.(*config.BrokerServer), dialNATS)
+	}},
+	"mqtt": {"MQTT", func() interface{} { return new(config.BrokerServer) }, func(bot *coolq.CQBot, c interface{}) func() {
+		return runBroker("MQTT", bot, c.(*config.BrokerServer), dialMQTT)
+	}},
+	"admin": {"管理 API", func() interface{} { return new(config.AdminServer) }, func(_ *coolq.CQBot, c interface{}) func() {
+		return RunAdminServer(c.(*config.AdminServer))
+	}},
+	"pprof": {"pprof", func() interface{} { return new(config.PprofServer) }, func(_ *coolq.CQBot, c interface{}) func() {
+		return RunPprofServer(c.(*config.PprofServer))
+	}},
+}
+
+// Manager 管理根据 servers 配置启动的通信服务
+//
+// 每个服务以类型与解析后的配置内容作为标识, 重新应用配置时
+// 配置未改变的服务保持运行, 删除或修改的服务将被停止, 新增或修改的服务将被启动.
+// 启动失败的服务不会被记录, 下次应用配置时将重新尝试启动.
+type Manager struct {
+	bot     *coolq.CQBot
+	lock    sync.Mutex
+	running map[string]func()
+	started bool // 已应用过配置, 之后的 Apply 均为重新加载
+}
+
+// reloading 为 1 时通信服务按重新加载的配置启动, 此时监听失败不会退出进程
+var reloading int32
+
+// listen 监听 addr, 用于启动通信服务
+//
+// 启动时监听失败将在五秒后退出进程; 重新加载配置时仅输出日志并返回错误,
+// 由调用方跳过该服务, 以免断开已登录的会话.
+func listen(name, addr string) (net.Listener, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err == nil {
+		return lis, nil
+	}
+	if atomic.LoadInt32(&reloading) == 1 {
+		log.Errorf("%v 监听 %v 失败, 已跳过该服务: %v", name, addr, err)
+		return nil, err
+	}
+	log.Error(err)
+	log.Infof("%v 启动失败, 请检查端口是否被占用.", name)
+	log.Warnf("将在五秒后退出.")
+	time.Sleep(time.Second * 5)
+	os.Exit(1)
+	return nil, err
+}
+
+// NewManager 创建通信服务管理器
+func NewManager(bot *coolq.CQBot) *Manager {
+	return &Manager{bot: bot, running: make(map[string]func())}
+}
+
+type pendingServer struct {
+	runner runner
+	conf   interface{}
+}
+
+// Apply 按 servers 配置启动或停止通信服务
+func (m *Manager) Apply(servers []map[string]yaml.Node) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.started {
+		atomic.StoreInt32(&reloading, 1)
+	}
+	m.started = true
+
+	next := make(map[string]*pendingServer)
+	var order []string
+	seen := make(map[string]int)
+	for _, s := range servers {
+		for kind, node := range s {
+			r, ok := runners[kind]
+			if !ok {
+				continue
+			}
+			conf := r.conf()
+			if err := node.Decode(conf); err != nil {
+				log.Warnf("读取%v配置失败 : %v", r.name, err)
+				continue
+			}
+			// 使用解析后的配置作为标识, 以便锚点引用的内容改变时也能识别
+			data, _ := yaml.Marshal(conf)
+			key := kind + "\n" + string(data)
+			seen[key]++
+			key += strconv.Itoa(seen[key])
+			next[key] = &pendingServer{runner: r, conf: conf}
+			order = append(order, key)
+		}
+	}
+
+	for key, stop := range m.running {
+		if _, ok := next[key]; !ok {
+			stop()
+			delete(m.running, key)
+		}
+	}
+	for _, key := range order {
+		if _, ok := m.running[key]; ok {
+			continue
+		}
+		p := next[key]
+		if stop := p.runner.run(m.bot, p.conf); stop != nil {
+			m.running[key] = stop
+		}
+	}
+}
+
+// Close 停止所有通信服务
+func (m *Manager) Close() {
+	m.Apply(nil)
+}
+
+// ReloadConfig 重新加载配置文件, 由 main 设置
+var ReloadConfig func() error
+
+func reloadConfig(_ *coolq.CQBot, _ resultGetter) coolq.MSG {
+	if ReloadConfig == nil {
+		return coolq.Failed(100, "RELOAD_UNSUPPORTED", "当前不支持重新加载配置")
+	}
+	if err := ReloadConfig(); err != nil {
+		return coolq.Failed(100, "RELOAD_FAILED", err.Error())
+	}
+	return coolq.OK(nil)
+}
+
+// ReloadFilters 重新读取所有已加载的事件过滤器
+func ReloadFilters() {
+	filterMutex.RLock()
+	files := make([]string, 0, len(filters))
+	for file := range filters {
+		files = append(files, file)
+	}
+	filterMutex.RUnlock()
+	for _, file := range files {
+		addFilter(file)
+	}
+}
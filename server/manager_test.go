@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+func TestManagerRetryFailed(t *testing.T) {
+	var runs, stops int
+	fail := true
+	runners["test"] = runner{"test", func() interface{} { return new(map[string]int) }, func(*coolq.CQBot, interface{}) func() {
+		runs++
+		if fail {
+			return nil
+		}
+		return func() { stops++ }
+	}}
+	defer delete(runners, "test")
+
+	var node yaml.Node
+	assert.NoError(t, yaml.Unmarshal([]byte("port: 1"), &node))
+	servers := []map[string]yaml.Node{{"test": *node.Content[0]}}
+	m := NewManager(nil)
+	m.Apply(servers)
+	assert.Equal(t, 1, runs)
+	assert.Empty(t, m.running)
+
+	// 启动失败的服务在下次应用配置时重新启动
+	fail = false
+	m.Apply(servers)
+	assert.Equal(t, 2, runs)
+	m.Apply(servers)
+	assert.Equal(t, 2, runs)
+
+	m.Close()
+	assert.Equal(t, 1, stops)
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
+)
+
+// RunMetricsServer 启动 Prometheus 指标服务器, 返回的函数用于停止该服务器, 监听失败时返回 nil
+func RunMetricsServer(conf *config.MetricsServer) (stop func()) {
+	if conf.Disabled {
+		return func() {}
+	}
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	tokens := newAccessTokens(&config.MiddleWares{AccessToken: conf.AccessToken})
+	handler := metrics.Handler()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if status, _ := checkAuth(r, tokens); status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+	server := http.Server{Addr: addr, Handler: mux}
+	lis, err := listen("指标服务", addr)
+	if err != nil {
+		return nil
+	}
+	go func() {
+		log.Infof("Prometheus 指标服务器已启动: %v/metrics", addr)
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Prometheus 指标服务器 %v 出现错误: %v", addr, err)
+		}
+	}()
+	return func() {
+		_ = server.Close()
+		log.Infof("Prometheus 指标服务器已停止: %v", addr)
+	}
+}
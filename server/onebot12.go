@@ -0,0 +1,561 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+)
+
+// OneBot v12 适配
+//
+// 启用 onebot-version: 12 的通信服务在通信层将 v11 格式的事件转换为 v12 格式后上报,
+// 并将收到的 v12 动作转换为 v11 的 API 调用, 未在 v12 中定义的 v11 API 可以通过
+// qq. 前缀调用, 如 qq.set_group_ban.
+
+const (
+	v12Impl     = "go-cqhttp"
+	v12Platform = "qq"
+	v12Prefix   = v12Platform + "."
+)
+
+// isV12 判断 onebot-version 配置是否为 v12
+func isV12(version int) bool {
+	return version == 12
+}
+
+// v12Actions OneBot v12 标准动作
+var v12Actions = map[string]func(*coolq.CQBot, resultGetter) coolq.MSG{
+	"get_status":            v12GetStatus,
+	"get_version":           v12GetVersion,
+	"get_self_info":         v12GetSelfInfo,
+	"get_user_info":         v12GetUserInfo,
+	"get_friend_list":       v12GetFriendList,
+	"send_message":          v12SendMessage,
+	"delete_message":        v12DeleteMessage,
+	"get_group_info":        v12GetGroupInfo,
+	"get_group_list":        v12GetGroupList,
+	"get_group_member_info": v12GetGroupMemberInfo,
+	"get_group_member_list": v12GetGroupMemberList,
+	"set_group_name":        v12SetGroupName,
+	"leave_group":           v12LeaveGroup,
+}
+
+func init() {
+	// get_supported_actions 需要遍历 v12Actions, 不能在初始化时直接引用
+	v12Actions["get_supported_actions"] = v12GetSupportedActions
+}
+
+// findV12Action 返回 v12 动作的处理函数, qq. 前缀的动作使用对应的 v11 API
+func findV12Action(action string) (func(*coolq.CQBot, resultGetter) coolq.MSG, bool) {
+	if f, ok := v12Actions[action]; ok {
+		return f, true
+	}
+	if strings.HasPrefix(action, v12Prefix) {
+		f, ok := API[strings.TrimPrefix(action, v12Prefix)]
+		return f, ok
+	}
+	return nil, false
+}
+
+// v12V11Actions v12 标准动作实际调用的 v11 API, 用于按 v11 的名称检查令牌权限
+var v12V11Actions = map[string]string{
+	"get_status":            "get_status",
+	"get_version":           "get_version_info",
+	"get_self_info":         "get_login_info",
+	"get_user_info":         "get_stranger_info",
+	"get_friend_list":       "get_friend_list",
+	"delete_message":        "delete_msg",
+	"get_group_info":        "get_group_info",
+	"get_group_list":        "get_group_list",
+	"get_group_member_info": "get_group_member_info",
+	"get_group_member_list": "get_group_member_list",
+	"set_group_name":        "set_group_name",
+	"leave_group":           "set_group_leave",
+}
+
+// v11Action 返回 v12 动作实际调用的 v11 API, 没有对应的 API 时返回空字符串
+func v11Action(action string, p resultGetter) string {
+	if strings.HasPrefix(action, v12Prefix) {
+		return strings.TrimPrefix(action, v12Prefix)
+	}
+	if action == "send_message" {
+		switch p.Get("detail_type").String() {
+		case "private":
+			return "send_private_msg"
+		case "group":
+			return "send_group_msg"
+		}
+	}
+	return v12V11Actions[action]
+}
+
+// v12Result 将 v11 格式的响应转换为 v12 格式
+func v12Result(ret coolq.MSG) coolq.MSG {
+	retcode, _ := ret["retcode"].(int)
+	r := coolq.MSG{
+		"status":  ret["status"],
+		"retcode": 0,
+		"data":    ret["data"],
+		"message": "",
+	}
+	if retcode == 0 {
+		return r
+	}
+	if ret["status"] == "async" { // 消息仍在发送队列中
+		r["status"] = "ok"
+		return r
+	}
+	r["data"] = nil
+	switch {
+	case ret["msg"] == "API_NOT_FOUND":
+		r["retcode"] = 10002 // unsupported action
+	case ret["msg"] == "BOT_NOT_FOUND":
+		r["retcode"] = 10102 // unknown self
+	case retcode == 100:
+		r["retcode"] = 10003 // bad param
+	default:
+		r["retcode"] = 20002 // internal handler error
+	}
+	r["message"] = ret["wording"]
+	if r["message"] == nil || r["message"] == "" {
+		r["message"] = ret["msg"]
+	}
+	return r
+}
+
+// v12Call 以 params 调用 v11 API, 并返回响应中 data 的 JSON
+func v12Call(bot *coolq.CQBot, action string, params coolq.MSG) (coolq.MSG, gjson.Result) {
+	body, _ := json.Marshal(params)
+	ret := API[action](bot, gjson.ParseBytes(body))
+	if ret["retcode"] != 0 {
+		return ret, gjson.Result{}
+	}
+	data, _ := json.Marshal(ret["data"])
+	return ret, gjson.ParseBytes(data)
+}
+
+// v12ID 将 v12 中的字符串 ID 转换为 v11 使用的整数
+func v12ID(p resultGetter, key string) int64 {
+	return p.Get(key).Int()
+}
+
+func v12GetSupportedActions(_ *coolq.CQBot, _ resultGetter) coolq.MSG {
+	actions := make([]string, 0, len(v12Actions)+len(API))
+	for action := range v12Actions {
+		actions = append(actions, action)
+	}
+	for action := range API {
+		actions = append(actions, v12Prefix+action)
+	}
+	sort.Strings(actions)
+	return coolq.OK(actions)
+}
+
+// v12GetStatus 返回调用的账号的状态, 多账号共享的连接也仅包含路由到的账号
+func v12GetStatus(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
+	return coolq.OK(coolq.MSG{
+		"good": bot.Client.Online,
+		"bots": []coolq.MSG{{
+			"self":   coolq.MSG{"platform": v12Platform, "user_id": strconv.FormatInt(bot.Client.Uin, 10)},
+			"online": bot.Client.Online,
+		}},
+	})
+}
+
+func v12GetVersion(_ *coolq.CQBot, _ resultGetter) coolq.MSG {
+	return coolq.OK(coolq.MSG{
+		"impl":           v12Impl,
+		"version":        coolq.Version,
+		"onebot_version": "12",
+	})
+}
+
+func v12GetSelfInfo(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
+	return coolq.OK(coolq.MSG{
+		"user_id":          strconv.FormatInt(bot.Client.Uin, 10),
+		"user_name":        bot.Client.Nickname,
+		"user_displayname": "",
+	})
+}
+
+func v12GetUserInfo(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_stranger_info", coolq.MSG{"user_id": v12ID(p, "user_id")})
+	if !data.Exists() {
+		return ret
+	}
+	remark := ""
+	if f := bot.Client.FindFriend(data.Get("user_id").Int()); f != nil {
+		remark = f.Remark
+	}
+	return coolq.OK(coolq.MSG{
+		"user_id":          data.Get("user_id").String(),
+		"user_name":        data.Get("nickname").String(),
+		"user_displayname": "",
+		"user_remark":      remark,
+	})
+}
+
+func v12GetFriendList(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_friend_list", nil)
+	if !data.Exists() {
+		return ret
+	}
+	list := make([]coolq.MSG, 0, len(data.Array()))
+	data.ForEach(func(_, f gjson.Result) bool {
+		list = append(list, coolq.MSG{
+			"user_id":          f.Get("user_id").String(),
+			"user_name":        f.Get("nickname").String(),
+			"user_displayname": "",
+			"user_remark":      f.Get("remark").String(),
+		})
+		return true
+	})
+	return coolq.OK(list)
+}
+
+func v12SendMessage(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	message := v12ToV11Message(p.Get("message"))
+	var (
+		ret  coolq.MSG
+		data gjson.Result
+	)
+	switch p.Get("detail_type").String() {
+	case "private":
+		ret, data = v12Call(bot, "send_private_msg", coolq.MSG{
+			"user_id":  v12ID(p, "user_id"),
+			"group_id": v12ID(p, "group_id"),
+			"message":  message,
+			"priority": p.Get(`qq\.priority`).String(),
+		})
+	case "group":
+		ret, data = v12Call(bot, "send_group_msg", coolq.MSG{
+			"group_id": v12ID(p, "group_id"),
+			"message":  message,
+			"priority": p.Get(`qq\.priority`).String(),
+		})
+	default:
+		return coolq.Failed(100, "UNSUPPORTED_DETAIL_TYPE", "不支持的 detail_type")
+	}
+	if ret["status"] == "async" {
+		return ret
+	}
+	if !data.Exists() {
+		return ret
+	}
+	return coolq.OK(coolq.MSG{
+		"message_id": data.Get("message_id").String(),
+		"time":       time.Now().Unix(),
+	})
+}
+
+func v12DeleteMessage(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, _ := v12Call(bot, "delete_msg", coolq.MSG{"message_id": v12ID(p, "message_id")})
+	return ret
+}
+
+func v12GroupInfo(g gjson.Result) coolq.MSG {
+	return coolq.MSG{
+		"group_id":   g.Get("group_id").String(),
+		"group_name": g.Get("group_name").String(),
+	}
+}
+
+func v12GetGroupInfo(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_group_info", coolq.MSG{"group_id": v12ID(p, "group_id")})
+	if !data.Exists() {
+		return ret
+	}
+	return coolq.OK(v12GroupInfo(data))
+}
+
+func v12GetGroupList(bot *coolq.CQBot, _ resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_group_list", nil)
+	if !data.Exists() {
+		return ret
+	}
+	list := make([]coolq.MSG, 0, len(data.Array()))
+	data.ForEach(func(_, g gjson.Result) bool {
+		list = append(list, v12GroupInfo(g))
+		return true
+	})
+	return coolq.OK(list)
+}
+
+func v12MemberInfo(m gjson.Result) coolq.MSG {
+	return coolq.MSG{
+		"user_id":          m.Get("user_id").String(),
+		"user_name":        m.Get("nickname").String(),
+		"user_displayname": m.Get("card").String(),
+	}
+}
+
+func v12GetGroupMemberInfo(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_group_member_info", coolq.MSG{
+		"group_id": v12ID(p, "group_id"),
+		"user_id":  v12ID(p, "user_id"),
+	})
+	if !data.Exists() {
+		return ret
+	}
+	return coolq.OK(v12MemberInfo(data))
+}
+
+func v12GetGroupMemberList(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, data := v12Call(bot, "get_group_member_list", coolq.MSG{"group_id": v12ID(p, "group_id")})
+	if !data.Exists() {
+		return ret
+	}
+	list := make([]coolq.MSG, 0, len(data.Array()))
+	data.ForEach(func(_, m gjson.Result) bool {
+		list = append(list, v12MemberInfo(m))
+		return true
+	})
+	return coolq.OK(list)
+}
+
+func v12SetGroupName(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, _ := v12Call(bot, "set_group_name", coolq.MSG{
+		"group_id":   v12ID(p, "group_id"),
+		"group_name": p.Get("group_name").String(),
+	})
+	return ret
+}
+
+func v12LeaveGroup(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	ret, _ := v12Call(bot, "set_group_leave", coolq.MSG{"group_id": v12ID(p, "group_id")})
+	return ret
+}
+
+// v12ToV11Message 将 v12 消息段数组转换为 v11 消息段数组, 再由 ConvertObjectMessage 转换为消息元素
+func v12ToV11Message(m gjson.Result) []coolq.MSG {
+	r := []coolq.MSG{}
+	convert := func(seg gjson.Result) {
+		t := seg.Get("type").String()
+		data := seg.Get("data")
+		d := coolq.MSG{}
+		data.ForEach(func(k, v gjson.Result) bool {
+			d[k.Str] = v.Value()
+			return true
+		})
+		switch t {
+		case "text":
+		case "mention":
+			d = coolq.MSG{"qq": data.Get("user_id").String()}
+			t = "at"
+		case "mention_all":
+			d = coolq.MSG{"qq": "all"}
+			t = "at"
+		case "image", "video":
+			d["file"] = data.Get("file_id").String()
+		case "voice", "audio":
+			d["file"] = data.Get("file_id").String()
+			t = "record"
+		case "reply":
+			d = coolq.MSG{"id": data.Get("message_id").String()}
+		case "location":
+			d = coolq.MSG{
+				"lat":     data.Get("latitude").String(),
+				"lon":     data.Get("longitude").String(),
+				"title":   data.Get("title").String(),
+				"content": data.Get("content").String(),
+			}
+		default:
+			t = strings.TrimPrefix(t, v12Prefix)
+		}
+		r = append(r, coolq.MSG{"type": t, "data": d})
+	}
+	switch {
+	case m.Type == gjson.String:
+		r = append(r, coolq.MSG{"type": "text", "data": coolq.MSG{"text": m.Str}})
+	case m.IsArray():
+		m.ForEach(func(_, seg gjson.Result) bool {
+			convert(seg)
+			return true
+		})
+	case m.IsObject():
+		convert(m)
+	}
+	return r
+}
+
+// v11ToV12Message 将 v11 消息转换为 v12 消息段数组, 字符串格式的消息先解析为消息段
+func v11ToV12Message(m gjson.Result) []coolq.MSG {
+	var segments gjson.Result
+	if m.Type == gjson.String {
+		body, _ := json.Marshal(coolq.ParseCQCodeMessage(m.Str))
+		segments = gjson.ParseBytes(body)
+	} else {
+		segments = m
+	}
+	r := []coolq.MSG{}
+	segments.ForEach(func(_, seg gjson.Result) bool {
+		t := seg.Get("type").String()
+		data := seg.Get("data")
+		d := coolq.MSG{}
+		switch t {
+		case "text":
+			d["text"] = data.Get("text").String()
+		case "at":
+			if data.Get("qq").String() == "all" {
+				t = "mention_all"
+			} else {
+				t = "mention"
+				d["user_id"] = data.Get("qq").String()
+			}
+		case "image", "video", "record":
+			d["file_id"] = data.Get("file").String()
+			if url := data.Get("url"); url.Exists() {
+				d["url"] = url.String()
+			}
+			if t == "record" {
+				t = "voice"
+			}
+		case "reply":
+			t = "reply"
+			d["message_id"] = data.Get("id").String()
+			if qq := data.Get("qq"); qq.Exists() {
+				d["user_id"] = qq.String()
+			}
+		case "location":
+			d = coolq.MSG{
+				"latitude":  data.Get("lat").Float(),
+				"longitude": data.Get("lon").Float(),
+				"title":     data.Get("title").String(),
+				"content":   data.Get("content").String(),
+			}
+		default:
+			t = v12Prefix + t
+			data.ForEach(func(k, v gjson.Result) bool {
+				d[k.Str] = v.Value()
+				return true
+			})
+		}
+		r = append(r, coolq.MSG{"type": t, "data": d})
+		return true
+	})
+	return r
+}
+
+// v12DetailTypes v11 事件类型到 v12 detail_type 的映射, 未列出的类型使用 qq. 前缀
+var v12DetailTypes = map[string]string{
+	"private":        "private",
+	"group":          "group",
+	"heartbeat":      "heartbeat",
+	"group_increase": "group_member_increase",
+	"group_decrease": "group_member_decrease",
+	"friend_add":     "friend_increase",
+	"group_recall":   "group_message_delete",
+	"friend_recall":  "private_message_delete",
+}
+
+// v12Fields v12 事件的标准字段, 其余 v11 字段使用 qq. 前缀
+var v12Fields = map[string]bool{
+	"user_id":     true,
+	"group_id":    true,
+	"operator_id": true,
+	"message_id":  true,
+	"interval":    true,
+	"status":      true,
+}
+
+// v12Event 将 v11 格式的事件转换为 v12 格式
+func v12Event(e gjson.Result) coolq.MSG {
+	postType := e.Get("post_type").String()
+	var typ, detail string
+	switch postType {
+	case "message", "message_sent":
+		typ, detail = "message", e.Get("message_type").String()
+	case "notice":
+		typ, detail = "notice", e.Get("notice_type").String()
+	case "request":
+		typ, detail = "request", e.Get("request_type").String()
+	default:
+		typ, detail = "meta", e.Get("meta_event_type").String()
+	}
+	if d, ok := v12DetailTypes[detail]; ok {
+		detail = d
+	} else {
+		detail = v12Prefix + detail
+	}
+	m := coolq.MSG{
+		"id":          v12EventID(e),
+		"impl":        v12Impl,
+		"platform":    v12Platform,
+		"self_id":     e.Get("self_id").String(),
+		"time":        e.Get("time").Int(),
+		"type":        typ,
+		"detail_type": detail,
+		"sub_type":    e.Get("sub_type").String(),
+	}
+	e.ForEach(func(k, v gjson.Result) bool {
+		switch k.Str {
+		case "post_type", "message_type", "notice_type", "request_type", "meta_event_type",
+			"sub_type", "self_id", "time", "event_seq":
+		case "message":
+			m["message"] = v11ToV12Message(v)
+		case "raw_message":
+			m["alt_message"] = v.String()
+		default:
+			if !v12Fields[k.Str] {
+				m[v12Prefix+k.Str] = v.Value()
+			} else if strings.HasSuffix(k.Str, "_id") {
+				m[k.Str] = v.String()
+			} else {
+				m[k.Str] = v.Value()
+			}
+		}
+		return true
+	})
+	if postType == "message_sent" {
+		m["sub_type"] = "self"
+	}
+	return m
+}
+
+// v12EventBytes 将 v11 格式的事件 JSON 转换为 v12 格式
+func v12EventBytes(event []byte) []byte {
+	body, _ := json.Marshal(v12Event(gjson.ParseBytes(event)))
+	return body
+}
+
+// v12EventID 事件 ID, 启用事件日志时使用 event_seq, 否则随机生成
+func v12EventID(e gjson.Result) string {
+	if seq := e.Get("event_seq"); seq.Exists() {
+		return seq.String()
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// v12ConnectEvent 连接建立后发送的 meta.connect 事件
+func v12ConnectEvent() []byte {
+	body, _ := json.Marshal(coolq.MSG{
+		"id":          v12EventID(gjson.Result{}),
+		"time":        time.Now().Unix(),
+		"type":        "meta",
+		"detail_type": "connect",
+		"sub_type":    "",
+		"version": coolq.MSG{
+			"impl":           v12Impl,
+			"version":        coolq.Version,
+			"onebot_version": "12",
+		},
+	})
+	return body
+}
+
+// eventPayload 返回事件在 OneBot v11 或 v12 格式下的 JSON
+func eventPayload(v12 bool, event []byte) []byte {
+	if !v12 {
+		return event
+	}
+	return v12EventBytes(event)
+}
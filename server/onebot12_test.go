@@ -0,0 +1,108 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestV12ToV11Message(t *testing.T) {
+	var tests = [...]struct {
+		v12      string
+		expected []coolq.MSG
+	}{
+		{`"hello"`, []coolq.MSG{{"type": "text", "data": coolq.MSG{"text": "hello"}}}},
+		{`{"type":"text","data":{"text":"hi"}}`, []coolq.MSG{{"type": "text", "data": coolq.MSG{"text": "hi"}}}},
+		{`[{"type":"mention","data":{"user_id":"10001"}},{"type":"mention_all","data":{}}]`, []coolq.MSG{
+			{"type": "at", "data": coolq.MSG{"qq": "10001"}},
+			{"type": "at", "data": coolq.MSG{"qq": "all"}},
+		}},
+		{`[{"type":"image","data":{"file_id":"a.image"}}]`, []coolq.MSG{{"type": "image", "data": coolq.MSG{"file_id": "a.image", "file": "a.image"}}}},
+		{`[{"type":"voice","data":{"file_id":"a.amr"}}]`, []coolq.MSG{{"type": "record", "data": coolq.MSG{"file_id": "a.amr", "file": "a.amr"}}}},
+		{`[{"type":"reply","data":{"message_id":"123","user_id":"10001"}}]`, []coolq.MSG{{"type": "reply", "data": coolq.MSG{"id": "123"}}}},
+		{`[{"type":"qq.face","data":{"id":"1"}}]`, []coolq.MSG{{"type": "face", "data": coolq.MSG{"id": "1"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v12, func(t *testing.T) {
+			assert.Equal(t, tt.expected, v12ToV11Message(gjson.Parse(tt.v12)))
+		})
+	}
+}
+
+func TestV12Event(t *testing.T) {
+	var tests = [...]struct {
+		v11      string
+		expected map[string]interface{}
+	}{
+		{
+			`{"post_type":"message","message_type":"group","sub_type":"normal","self_id":1,"time":2,"group_id":3,"user_id":4,"message_id":5,"message":"[CQ:at,qq=all]hi","raw_message":"[CQ:at,qq=all]hi","font":0,"event_seq":7}`,
+			map[string]interface{}{
+				"id": "7", "type": "message", "detail_type": "group", "sub_type": "normal", "self_id": "1",
+				"group_id": "3", "user_id": "4", "message_id": "5", "alt_message": "[CQ:at,qq=all]hi", "qq.font": float64(0),
+				"message": []coolq.MSG{
+					{"type": "mention_all", "data": coolq.MSG{}},
+					{"type": "text", "data": coolq.MSG{"text": "hi"}},
+				},
+			},
+		},
+		{
+			`{"post_type":"message_sent","message_type":"private","sub_type":"friend","self_id":1,"time":2,"user_id":4,"message":[{"type":"record","data":{"file":"a.amr"}}]}`,
+			map[string]interface{}{
+				"type": "message", "detail_type": "private", "sub_type": "self", "user_id": "4",
+				"message": []coolq.MSG{{"type": "voice", "data": coolq.MSG{"file_id": "a.amr"}}},
+			},
+		},
+		{
+			`{"post_type":"notice","notice_type":"group_recall","self_id":1,"time":2,"group_id":3,"operator_id":4,"message_id":5}`,
+			map[string]interface{}{"type": "notice", "detail_type": "group_message_delete", "operator_id": "4", "message_id": "5"},
+		},
+		{
+			`{"post_type":"notice","notice_type":"notify","sub_type":"poke","self_id":1,"time":2,"target_id":6}`,
+			map[string]interface{}{"type": "notice", "detail_type": "qq.notify", "sub_type": "poke", "qq.target_id": float64(6)},
+		},
+		{
+			`{"post_type":"meta_event","meta_event_type":"heartbeat","self_id":1,"time":2,"interval":5000}`,
+			map[string]interface{}{"type": "meta", "detail_type": "heartbeat", "interval": float64(5000), "impl": v12Impl, "platform": v12Platform},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v11, func(t *testing.T) {
+			e := v12Event(gjson.Parse(tt.v11))
+			for k, v := range tt.expected {
+				assert.Equal(t, v, e[k], k)
+			}
+			assert.NotContains(t, e, "post_type")
+			assert.NotContains(t, e, "event_seq")
+		})
+	}
+}
+
+func TestV12CheckScope(t *testing.T) {
+	api := newAPICaller(nil).withScope(newTokenScope(&config.AccessToken{
+		DenyActions: []string{"set_group_kick", "set_group_leave", "send_group_msg"},
+	}))
+	api.v12 = true
+	var tests = [...]struct {
+		action  string
+		params  string
+		allowed bool
+	}{
+		{"get_status", `{}`, true},
+		{"qq.set_group_ban", `{"group_id":"1","user_id":"2"}`, true},
+		{"qq.set_group_kick", `{"group_id":"1","user_id":"2"}`, false},
+		{"leave_group", `{"group_id":"1"}`, false},
+		{"send_message", `{"detail_type":"private","user_id":"2","message":"hi"}`, true},
+		{"send_message", `{"detail_type":"group","group_id":"1","message":"hi"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.action+tt.params, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, api.checkScope(nil, tt.action, gjson.Parse(tt.params)) == nil)
+		})
+	}
+	assert.Equal(t, "set_group_leave", v11Action("leave_group", gjson.Parse(`{}`)))
+	assert.Equal(t, "", v11Action("unknown", gjson.Parse(`{}`)))
+}
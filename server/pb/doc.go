@@ -0,0 +1,6 @@
+// Package pb 包含 gRPC 通信协议的 protobuf 定义与生成的代码
+//
+// 修改 onebot.proto 后需要重新生成代码, 需要安装 protoc, protoc-gen-go 与 protoc-gen-go-grpc.
+package pb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative onebot.proto
@@ -0,0 +1,2547 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: onebot.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OneBotClient is the client API for OneBot service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OneBotClient interface {
+	// 订阅事件, 每个事件推送一次, 连接断开期间的事件不会补发
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (OneBot_SubscribeEventsClient, error)
+	GetLoginInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetLoginInfoResponse, error)
+	QidianGetAccountInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QidianGetAccountInfoResponse, error)
+	GetFriendList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetFriendListResponse, error)
+	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetGroupList(ctx context.Context, in *GetGroupListRequest, opts ...grpc.CallOption) (*GetGroupListResponse, error)
+	GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest, opts ...grpc.CallOption) (*GroupInfo, error)
+	GetGroupMemberList(ctx context.Context, in *GetGroupMemberListRequest, opts ...grpc.CallOption) (*GetGroupMemberListResponse, error)
+	GetGroupMemberInfo(ctx context.Context, in *GetGroupMemberInfoRequest, opts ...grpc.CallOption) (*GroupMemberInfo, error)
+	GetStrangerInfo(ctx context.Context, in *GetStrangerInfoRequest, opts ...grpc.CallOption) (*GetStrangerInfoResponse, error)
+	GetVipInfo(ctx context.Context, in *GetVipInfoRequest, opts ...grpc.CallOption) (*GetVipInfoResponse, error)
+	SendMsg(ctx context.Context, in *SendMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error)
+	SendGroupMsg(ctx context.Context, in *SendGroupMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error)
+	SendPrivateMsg(ctx context.Context, in *SendPrivateMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error)
+	SendGroupForwardMsg(ctx context.Context, in *SendGroupForwardMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error)
+	DeleteMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*GetMsgResponse, error)
+	GetForwardMsg(ctx context.Context, in *GetForwardMsgRequest, opts ...grpc.CallOption) (*GetForwardMsgResponse, error)
+	GetGroupMsgHistory(ctx context.Context, in *GetGroupMsgHistoryRequest, opts ...grpc.CallOption) (*GetGroupMsgHistoryResponse, error)
+	SetEssenceMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteEssenceMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetEssenceMsgList(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*GetEssenceMsgListResponse, error)
+	SetFriendAddRequest(ctx context.Context, in *SetFriendAddRequestRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupAddRequest(ctx context.Context, in *SetGroupAddRequestRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetGroupSystemMsg(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataResponse, error)
+	SetGroupCard(ctx context.Context, in *SetGroupCardRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupSpecialTitle(ctx context.Context, in *SetGroupSpecialTitleRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupKick(ctx context.Context, in *SetGroupKickRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupBan(ctx context.Context, in *SetGroupBanRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupWholeBan(ctx context.Context, in *SetGroupWholeBanRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupAnonymousBan(ctx context.Context, in *SetGroupAnonymousBanRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupName(ctx context.Context, in *SetGroupNameRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupPortrait(ctx context.Context, in *SetGroupPortraitRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGroupLeave(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendGroupNotice(ctx context.Context, in *SendGroupNoticeRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetGroupHonorInfo(ctx context.Context, in *GetGroupHonorInfoRequest, opts ...grpc.CallOption) (*GetGroupHonorInfoResponse, error)
+	GetGroupAtAllRemain(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*DataResponse, error)
+	GetGroupFileSystemInfo(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*DataResponse, error)
+	GetGroupRootFiles(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*GetGroupFilesResponse, error)
+	GetGroupFilesByFolder(ctx context.Context, in *GetGroupFilesByFolderRequest, opts ...grpc.CallOption) (*GetGroupFilesResponse, error)
+	GetGroupFileUrl(ctx context.Context, in *GetGroupFileUrlRequest, opts ...grpc.CallOption) (*GetGroupFileUrlResponse, error)
+	UploadGroupFile(ctx context.Context, in *UploadGroupFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateGroupFileFolder(ctx context.Context, in *CreateGroupFileFolderRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteGroupFolder(ctx context.Context, in *DeleteGroupFolderRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteGroupFile(ctx context.Context, in *DeleteGroupFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error)
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error)
+	OcrImage(ctx context.Context, in *OcrImageRequest, opts ...grpc.CallOption) (*DataResponse, error)
+	CanSendImage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*YesResponse, error)
+	CanSendRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*YesResponse, error)
+	GetWordSlices(ctx context.Context, in *GetWordSlicesRequest, opts ...grpc.CallOption) (*GetWordSlicesResponse, error)
+	CheckUrlSafely(ctx context.Context, in *CheckUrlSafelyRequest, opts ...grpc.CallOption) (*CheckUrlSafelyResponse, error)
+	GetModelShow(ctx context.Context, in *GetModelShowRequest, opts ...grpc.CallOption) (*GetModelShowResponse, error)
+	SetModelShow(ctx context.Context, in *SetModelShowRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetOnlineClients(ctx context.Context, in *GetOnlineClientsRequest, opts ...grpc.CallOption) (*GetOnlineClientsResponse, error)
+	HandleQuickOperation(ctx context.Context, in *HandleQuickOperationRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	GetVersionInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataResponse, error)
+	SetRestart(ctx context.Context, in *SetRestartRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReloadConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ReloadEventFilter(ctx context.Context, in *ReloadEventFilterRequest, opts ...grpc.CallOption) (*Empty, error)
+	TestEventFilter(ctx context.Context, in *TestEventFilterRequest, opts ...grpc.CallOption) (*TestEventFilterResponse, error)
+	SubmitLoginChallenge(ctx context.Context, in *SubmitLoginChallengeRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetTaskResult(ctx context.Context, in *GetTaskResultRequest, opts ...grpc.CallOption) (*GetTaskResultResponse, error)
+	GetPendingEvents(ctx context.Context, in *GetPendingEventsRequest, opts ...grpc.CallOption) (*GetPendingEventsResponse, error)
+	FlushEventQueue(ctx context.Context, in *FlushEventQueueRequest, opts ...grpc.CallOption) (*FlushEventQueueResponse, error)
+	GetEventsSince(ctx context.Context, in *GetEventsSinceRequest, opts ...grpc.CallOption) (*GetEventsSinceResponse, error)
+}
+
+type oneBotClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOneBotClient(cc grpc.ClientConnInterface) OneBotClient {
+	return &oneBotClient{cc}
+}
+
+func (c *oneBotClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (OneBot_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OneBot_ServiceDesc.Streams[0], "/onebot.OneBot/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oneBotSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OneBot_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type oneBotSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *oneBotSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oneBotClient) GetLoginInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetLoginInfoResponse, error) {
+	out := new(GetLoginInfoResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetLoginInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) QidianGetAccountInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QidianGetAccountInfoResponse, error) {
+	out := new(QidianGetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/QidianGetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetFriendList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetFriendListResponse, error) {
+	out := new(GetFriendListResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetFriendList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DeleteFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupList(ctx context.Context, in *GetGroupListRequest, opts ...grpc.CallOption) (*GetGroupListResponse, error) {
+	out := new(GetGroupListResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupInfo(ctx context.Context, in *GetGroupInfoRequest, opts ...grpc.CallOption) (*GroupInfo, error) {
+	out := new(GroupInfo)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupMemberList(ctx context.Context, in *GetGroupMemberListRequest, opts ...grpc.CallOption) (*GetGroupMemberListResponse, error) {
+	out := new(GetGroupMemberListResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupMemberList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupMemberInfo(ctx context.Context, in *GetGroupMemberInfoRequest, opts ...grpc.CallOption) (*GroupMemberInfo, error) {
+	out := new(GroupMemberInfo)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupMemberInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetStrangerInfo(ctx context.Context, in *GetStrangerInfoRequest, opts ...grpc.CallOption) (*GetStrangerInfoResponse, error) {
+	out := new(GetStrangerInfoResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetStrangerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetVipInfo(ctx context.Context, in *GetVipInfoRequest, opts ...grpc.CallOption) (*GetVipInfoResponse, error) {
+	out := new(GetVipInfoResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetVipInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SendMsg(ctx context.Context, in *SendMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error) {
+	out := new(SendMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SendMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SendGroupMsg(ctx context.Context, in *SendGroupMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error) {
+	out := new(SendMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SendGroupMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SendPrivateMsg(ctx context.Context, in *SendPrivateMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error) {
+	out := new(SendMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SendPrivateMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SendGroupForwardMsg(ctx context.Context, in *SendGroupForwardMsgRequest, opts ...grpc.CallOption) (*SendMsgResponse, error) {
+	out := new(SendMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SendGroupForwardMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DeleteMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DeleteMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*GetMsgResponse, error) {
+	out := new(GetMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetForwardMsg(ctx context.Context, in *GetForwardMsgRequest, opts ...grpc.CallOption) (*GetForwardMsgResponse, error) {
+	out := new(GetForwardMsgResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetForwardMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupMsgHistory(ctx context.Context, in *GetGroupMsgHistoryRequest, opts ...grpc.CallOption) (*GetGroupMsgHistoryResponse, error) {
+	out := new(GetGroupMsgHistoryResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupMsgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetEssenceMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetEssenceMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DeleteEssenceMsg(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DeleteEssenceMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetEssenceMsgList(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*GetEssenceMsgListResponse, error) {
+	out := new(GetEssenceMsgListResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetEssenceMsgList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetFriendAddRequest(ctx context.Context, in *SetFriendAddRequestRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetFriendAddRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupAddRequest(ctx context.Context, in *SetGroupAddRequestRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupAddRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupSystemMsg(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataResponse, error) {
+	out := new(DataResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupSystemMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupCard(ctx context.Context, in *SetGroupCardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupSpecialTitle(ctx context.Context, in *SetGroupSpecialTitleRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupSpecialTitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupKick(ctx context.Context, in *SetGroupKickRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupKick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupBan(ctx context.Context, in *SetGroupBanRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupWholeBan(ctx context.Context, in *SetGroupWholeBanRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupWholeBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupAnonymousBan(ctx context.Context, in *SetGroupAnonymousBanRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupAnonymousBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupName(ctx context.Context, in *SetGroupNameRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupPortrait(ctx context.Context, in *SetGroupPortraitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupPortrait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetGroupLeave(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetGroupLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SendGroupNotice(ctx context.Context, in *SendGroupNoticeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SendGroupNotice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupHonorInfo(ctx context.Context, in *GetGroupHonorInfoRequest, opts ...grpc.CallOption) (*GetGroupHonorInfoResponse, error) {
+	out := new(GetGroupHonorInfoResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupHonorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupAtAllRemain(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*DataResponse, error) {
+	out := new(DataResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupAtAllRemain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupFileSystemInfo(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*DataResponse, error) {
+	out := new(DataResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupFileSystemInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupRootFiles(ctx context.Context, in *GroupIdRequest, opts ...grpc.CallOption) (*GetGroupFilesResponse, error) {
+	out := new(GetGroupFilesResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupRootFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupFilesByFolder(ctx context.Context, in *GetGroupFilesByFolderRequest, opts ...grpc.CallOption) (*GetGroupFilesResponse, error) {
+	out := new(GetGroupFilesResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupFilesByFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetGroupFileUrl(ctx context.Context, in *GetGroupFileUrlRequest, opts ...grpc.CallOption) (*GetGroupFileUrlResponse, error) {
+	out := new(GetGroupFileUrlResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetGroupFileUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) UploadGroupFile(ctx context.Context, in *UploadGroupFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/UploadGroupFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) CreateGroupFileFolder(ctx context.Context, in *CreateGroupFileFolderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/CreateGroupFileFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DeleteGroupFolder(ctx context.Context, in *DeleteGroupFolderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DeleteGroupFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DeleteGroupFile(ctx context.Context, in *DeleteGroupFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DeleteGroupFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error) {
+	out := new(GetImageResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error) {
+	out := new(DownloadFileResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/DownloadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) OcrImage(ctx context.Context, in *OcrImageRequest, opts ...grpc.CallOption) (*DataResponse, error) {
+	out := new(DataResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/OcrImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) CanSendImage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*YesResponse, error) {
+	out := new(YesResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/CanSendImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) CanSendRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*YesResponse, error) {
+	out := new(YesResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/CanSendRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetWordSlices(ctx context.Context, in *GetWordSlicesRequest, opts ...grpc.CallOption) (*GetWordSlicesResponse, error) {
+	out := new(GetWordSlicesResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetWordSlices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) CheckUrlSafely(ctx context.Context, in *CheckUrlSafelyRequest, opts ...grpc.CallOption) (*CheckUrlSafelyResponse, error) {
+	out := new(CheckUrlSafelyResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/CheckUrlSafely", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetModelShow(ctx context.Context, in *GetModelShowRequest, opts ...grpc.CallOption) (*GetModelShowResponse, error) {
+	out := new(GetModelShowResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetModelShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetModelShow(ctx context.Context, in *SetModelShowRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetModelShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetOnlineClients(ctx context.Context, in *GetOnlineClientsRequest, opts ...grpc.CallOption) (*GetOnlineClientsResponse, error) {
+	out := new(GetOnlineClientsResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetOnlineClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) HandleQuickOperation(ctx context.Context, in *HandleQuickOperationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/HandleQuickOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetVersionInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataResponse, error) {
+	out := new(DataResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetVersionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SetRestart(ctx context.Context, in *SetRestartRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SetRestart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) ReloadConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) ReloadEventFilter(ctx context.Context, in *ReloadEventFilterRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/ReloadEventFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) TestEventFilter(ctx context.Context, in *TestEventFilterRequest, opts ...grpc.CallOption) (*TestEventFilterResponse, error) {
+	out := new(TestEventFilterResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/TestEventFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) SubmitLoginChallenge(ctx context.Context, in *SubmitLoginChallengeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/SubmitLoginChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetTaskResult(ctx context.Context, in *GetTaskResultRequest, opts ...grpc.CallOption) (*GetTaskResultResponse, error) {
+	out := new(GetTaskResultResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetTaskResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetPendingEvents(ctx context.Context, in *GetPendingEventsRequest, opts ...grpc.CallOption) (*GetPendingEventsResponse, error) {
+	out := new(GetPendingEventsResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetPendingEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) FlushEventQueue(ctx context.Context, in *FlushEventQueueRequest, opts ...grpc.CallOption) (*FlushEventQueueResponse, error) {
+	out := new(FlushEventQueueResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/FlushEventQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oneBotClient) GetEventsSince(ctx context.Context, in *GetEventsSinceRequest, opts ...grpc.CallOption) (*GetEventsSinceResponse, error) {
+	out := new(GetEventsSinceResponse)
+	err := c.cc.Invoke(ctx, "/onebot.OneBot/GetEventsSince", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OneBotServer is the server API for OneBot service.
+// All implementations must embed UnimplementedOneBotServer
+// for forward compatibility
+type OneBotServer interface {
+	// 订阅事件, 每个事件推送一次, 连接断开期间的事件不会补发
+	SubscribeEvents(*SubscribeEventsRequest, OneBot_SubscribeEventsServer) error
+	GetLoginInfo(context.Context, *Empty) (*GetLoginInfoResponse, error)
+	QidianGetAccountInfo(context.Context, *Empty) (*QidianGetAccountInfoResponse, error)
+	GetFriendList(context.Context, *Empty) (*GetFriendListResponse, error)
+	DeleteFriend(context.Context, *DeleteFriendRequest) (*Empty, error)
+	GetGroupList(context.Context, *GetGroupListRequest) (*GetGroupListResponse, error)
+	GetGroupInfo(context.Context, *GetGroupInfoRequest) (*GroupInfo, error)
+	GetGroupMemberList(context.Context, *GetGroupMemberListRequest) (*GetGroupMemberListResponse, error)
+	GetGroupMemberInfo(context.Context, *GetGroupMemberInfoRequest) (*GroupMemberInfo, error)
+	GetStrangerInfo(context.Context, *GetStrangerInfoRequest) (*GetStrangerInfoResponse, error)
+	GetVipInfo(context.Context, *GetVipInfoRequest) (*GetVipInfoResponse, error)
+	SendMsg(context.Context, *SendMsgRequest) (*SendMsgResponse, error)
+	SendGroupMsg(context.Context, *SendGroupMsgRequest) (*SendMsgResponse, error)
+	SendPrivateMsg(context.Context, *SendPrivateMsgRequest) (*SendMsgResponse, error)
+	SendGroupForwardMsg(context.Context, *SendGroupForwardMsgRequest) (*SendMsgResponse, error)
+	DeleteMsg(context.Context, *MessageIdRequest) (*Empty, error)
+	GetMsg(context.Context, *MessageIdRequest) (*GetMsgResponse, error)
+	GetForwardMsg(context.Context, *GetForwardMsgRequest) (*GetForwardMsgResponse, error)
+	GetGroupMsgHistory(context.Context, *GetGroupMsgHistoryRequest) (*GetGroupMsgHistoryResponse, error)
+	SetEssenceMsg(context.Context, *MessageIdRequest) (*Empty, error)
+	DeleteEssenceMsg(context.Context, *MessageIdRequest) (*Empty, error)
+	GetEssenceMsgList(context.Context, *GroupIdRequest) (*GetEssenceMsgListResponse, error)
+	SetFriendAddRequest(context.Context, *SetFriendAddRequestRequest) (*Empty, error)
+	SetGroupAddRequest(context.Context, *SetGroupAddRequestRequest) (*Empty, error)
+	GetGroupSystemMsg(context.Context, *Empty) (*DataResponse, error)
+	SetGroupCard(context.Context, *SetGroupCardRequest) (*Empty, error)
+	SetGroupSpecialTitle(context.Context, *SetGroupSpecialTitleRequest) (*Empty, error)
+	SetGroupKick(context.Context, *SetGroupKickRequest) (*Empty, error)
+	SetGroupBan(context.Context, *SetGroupBanRequest) (*Empty, error)
+	SetGroupWholeBan(context.Context, *SetGroupWholeBanRequest) (*Empty, error)
+	SetGroupAnonymousBan(context.Context, *SetGroupAnonymousBanRequest) (*Empty, error)
+	SetGroupName(context.Context, *SetGroupNameRequest) (*Empty, error)
+	SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*Empty, error)
+	SetGroupPortrait(context.Context, *SetGroupPortraitRequest) (*Empty, error)
+	SetGroupLeave(context.Context, *GroupIdRequest) (*Empty, error)
+	SendGroupNotice(context.Context, *SendGroupNoticeRequest) (*Empty, error)
+	GetGroupHonorInfo(context.Context, *GetGroupHonorInfoRequest) (*GetGroupHonorInfoResponse, error)
+	GetGroupAtAllRemain(context.Context, *GroupIdRequest) (*DataResponse, error)
+	GetGroupFileSystemInfo(context.Context, *GroupIdRequest) (*DataResponse, error)
+	GetGroupRootFiles(context.Context, *GroupIdRequest) (*GetGroupFilesResponse, error)
+	GetGroupFilesByFolder(context.Context, *GetGroupFilesByFolderRequest) (*GetGroupFilesResponse, error)
+	GetGroupFileUrl(context.Context, *GetGroupFileUrlRequest) (*GetGroupFileUrlResponse, error)
+	UploadGroupFile(context.Context, *UploadGroupFileRequest) (*Empty, error)
+	CreateGroupFileFolder(context.Context, *CreateGroupFileFolderRequest) (*Empty, error)
+	DeleteGroupFolder(context.Context, *DeleteGroupFolderRequest) (*Empty, error)
+	DeleteGroupFile(context.Context, *DeleteGroupFileRequest) (*Empty, error)
+	GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error)
+	DownloadFile(context.Context, *DownloadFileRequest) (*DownloadFileResponse, error)
+	OcrImage(context.Context, *OcrImageRequest) (*DataResponse, error)
+	CanSendImage(context.Context, *Empty) (*YesResponse, error)
+	CanSendRecord(context.Context, *Empty) (*YesResponse, error)
+	GetWordSlices(context.Context, *GetWordSlicesRequest) (*GetWordSlicesResponse, error)
+	CheckUrlSafely(context.Context, *CheckUrlSafelyRequest) (*CheckUrlSafelyResponse, error)
+	GetModelShow(context.Context, *GetModelShowRequest) (*GetModelShowResponse, error)
+	SetModelShow(context.Context, *SetModelShowRequest) (*Empty, error)
+	GetOnlineClients(context.Context, *GetOnlineClientsRequest) (*GetOnlineClientsResponse, error)
+	HandleQuickOperation(context.Context, *HandleQuickOperationRequest) (*Empty, error)
+	GetStatus(context.Context, *Empty) (*GetStatusResponse, error)
+	GetVersionInfo(context.Context, *Empty) (*DataResponse, error)
+	SetRestart(context.Context, *SetRestartRequest) (*Empty, error)
+	ReloadConfig(context.Context, *Empty) (*Empty, error)
+	ReloadEventFilter(context.Context, *ReloadEventFilterRequest) (*Empty, error)
+	TestEventFilter(context.Context, *TestEventFilterRequest) (*TestEventFilterResponse, error)
+	SubmitLoginChallenge(context.Context, *SubmitLoginChallengeRequest) (*Empty, error)
+	GetTaskResult(context.Context, *GetTaskResultRequest) (*GetTaskResultResponse, error)
+	GetPendingEvents(context.Context, *GetPendingEventsRequest) (*GetPendingEventsResponse, error)
+	FlushEventQueue(context.Context, *FlushEventQueueRequest) (*FlushEventQueueResponse, error)
+	GetEventsSince(context.Context, *GetEventsSinceRequest) (*GetEventsSinceResponse, error)
+	mustEmbedUnimplementedOneBotServer()
+}
+
+// UnimplementedOneBotServer must be embedded to have forward compatible implementations.
+type UnimplementedOneBotServer struct {
+}
+
+func (UnimplementedOneBotServer) SubscribeEvents(*SubscribeEventsRequest, OneBot_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedOneBotServer) GetLoginInfo(context.Context, *Empty) (*GetLoginInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginInfo not implemented")
+}
+func (UnimplementedOneBotServer) QidianGetAccountInfo(context.Context, *Empty) (*QidianGetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QidianGetAccountInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetFriendList(context.Context, *Empty) (*GetFriendListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendList not implemented")
+}
+func (UnimplementedOneBotServer) DeleteFriend(context.Context, *DeleteFriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriend not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupList(context.Context, *GetGroupListRequest) (*GetGroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupList not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupInfo(context.Context, *GetGroupInfoRequest) (*GroupInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupMemberList(context.Context, *GetGroupMemberListRequest) (*GetGroupMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberList not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupMemberInfo(context.Context, *GetGroupMemberInfoRequest) (*GroupMemberInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetStrangerInfo(context.Context, *GetStrangerInfoRequest) (*GetStrangerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrangerInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetVipInfo(context.Context, *GetVipInfoRequest) (*GetVipInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVipInfo not implemented")
+}
+func (UnimplementedOneBotServer) SendMsg(context.Context, *SendMsgRequest) (*SendMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMsg not implemented")
+}
+func (UnimplementedOneBotServer) SendGroupMsg(context.Context, *SendGroupMsgRequest) (*SendMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupMsg not implemented")
+}
+func (UnimplementedOneBotServer) SendPrivateMsg(context.Context, *SendPrivateMsgRequest) (*SendMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPrivateMsg not implemented")
+}
+func (UnimplementedOneBotServer) SendGroupForwardMsg(context.Context, *SendGroupForwardMsgRequest) (*SendMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupForwardMsg not implemented")
+}
+func (UnimplementedOneBotServer) DeleteMsg(context.Context, *MessageIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMsg not implemented")
+}
+func (UnimplementedOneBotServer) GetMsg(context.Context, *MessageIdRequest) (*GetMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsg not implemented")
+}
+func (UnimplementedOneBotServer) GetForwardMsg(context.Context, *GetForwardMsgRequest) (*GetForwardMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForwardMsg not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupMsgHistory(context.Context, *GetGroupMsgHistoryRequest) (*GetGroupMsgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgHistory not implemented")
+}
+func (UnimplementedOneBotServer) SetEssenceMsg(context.Context, *MessageIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEssenceMsg not implemented")
+}
+func (UnimplementedOneBotServer) DeleteEssenceMsg(context.Context, *MessageIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEssenceMsg not implemented")
+}
+func (UnimplementedOneBotServer) GetEssenceMsgList(context.Context, *GroupIdRequest) (*GetEssenceMsgListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEssenceMsgList not implemented")
+}
+func (UnimplementedOneBotServer) SetFriendAddRequest(context.Context, *SetFriendAddRequestRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendAddRequest not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupAddRequest(context.Context, *SetGroupAddRequestRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAddRequest not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupSystemMsg(context.Context, *Empty) (*DataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSystemMsg not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupCard(context.Context, *SetGroupCardRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupCard not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupSpecialTitle(context.Context, *SetGroupSpecialTitleRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupSpecialTitle not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupKick(context.Context, *SetGroupKickRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupKick not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupBan(context.Context, *SetGroupBanRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupBan not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupWholeBan(context.Context, *SetGroupWholeBanRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupWholeBan not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupAnonymousBan(context.Context, *SetGroupAnonymousBanRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAnonymousBan not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupName(context.Context, *SetGroupNameRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupName not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAdmin not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupPortrait(context.Context, *SetGroupPortraitRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupPortrait not implemented")
+}
+func (UnimplementedOneBotServer) SetGroupLeave(context.Context, *GroupIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupLeave not implemented")
+}
+func (UnimplementedOneBotServer) SendGroupNotice(context.Context, *SendGroupNoticeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupNotice not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupHonorInfo(context.Context, *GetGroupHonorInfoRequest) (*GetGroupHonorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupHonorInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupAtAllRemain(context.Context, *GroupIdRequest) (*DataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupAtAllRemain not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupFileSystemInfo(context.Context, *GroupIdRequest) (*DataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupFileSystemInfo not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupRootFiles(context.Context, *GroupIdRequest) (*GetGroupFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRootFiles not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupFilesByFolder(context.Context, *GetGroupFilesByFolderRequest) (*GetGroupFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupFilesByFolder not implemented")
+}
+func (UnimplementedOneBotServer) GetGroupFileUrl(context.Context, *GetGroupFileUrlRequest) (*GetGroupFileUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupFileUrl not implemented")
+}
+func (UnimplementedOneBotServer) UploadGroupFile(context.Context, *UploadGroupFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadGroupFile not implemented")
+}
+func (UnimplementedOneBotServer) CreateGroupFileFolder(context.Context, *CreateGroupFileFolderRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupFileFolder not implemented")
+}
+func (UnimplementedOneBotServer) DeleteGroupFolder(context.Context, *DeleteGroupFolderRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupFolder not implemented")
+}
+func (UnimplementedOneBotServer) DeleteGroupFile(context.Context, *DeleteGroupFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupFile not implemented")
+}
+func (UnimplementedOneBotServer) GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImage not implemented")
+}
+func (UnimplementedOneBotServer) DownloadFile(context.Context, *DownloadFileRequest) (*DownloadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedOneBotServer) OcrImage(context.Context, *OcrImageRequest) (*DataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OcrImage not implemented")
+}
+func (UnimplementedOneBotServer) CanSendImage(context.Context, *Empty) (*YesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanSendImage not implemented")
+}
+func (UnimplementedOneBotServer) CanSendRecord(context.Context, *Empty) (*YesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanSendRecord not implemented")
+}
+func (UnimplementedOneBotServer) GetWordSlices(context.Context, *GetWordSlicesRequest) (*GetWordSlicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWordSlices not implemented")
+}
+func (UnimplementedOneBotServer) CheckUrlSafely(context.Context, *CheckUrlSafelyRequest) (*CheckUrlSafelyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUrlSafely not implemented")
+}
+func (UnimplementedOneBotServer) GetModelShow(context.Context, *GetModelShowRequest) (*GetModelShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelShow not implemented")
+}
+func (UnimplementedOneBotServer) SetModelShow(context.Context, *SetModelShowRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelShow not implemented")
+}
+func (UnimplementedOneBotServer) GetOnlineClients(context.Context, *GetOnlineClientsRequest) (*GetOnlineClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineClients not implemented")
+}
+func (UnimplementedOneBotServer) HandleQuickOperation(context.Context, *HandleQuickOperationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleQuickOperation not implemented")
+}
+func (UnimplementedOneBotServer) GetStatus(context.Context, *Empty) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedOneBotServer) GetVersionInfo(context.Context, *Empty) (*DataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionInfo not implemented")
+}
+func (UnimplementedOneBotServer) SetRestart(context.Context, *SetRestartRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRestart not implemented")
+}
+func (UnimplementedOneBotServer) ReloadConfig(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedOneBotServer) ReloadEventFilter(context.Context, *ReloadEventFilterRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadEventFilter not implemented")
+}
+func (UnimplementedOneBotServer) TestEventFilter(context.Context, *TestEventFilterRequest) (*TestEventFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestEventFilter not implemented")
+}
+func (UnimplementedOneBotServer) SubmitLoginChallenge(context.Context, *SubmitLoginChallengeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitLoginChallenge not implemented")
+}
+func (UnimplementedOneBotServer) GetTaskResult(context.Context, *GetTaskResultRequest) (*GetTaskResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskResult not implemented")
+}
+func (UnimplementedOneBotServer) GetPendingEvents(context.Context, *GetPendingEventsRequest) (*GetPendingEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingEvents not implemented")
+}
+func (UnimplementedOneBotServer) FlushEventQueue(context.Context, *FlushEventQueueRequest) (*FlushEventQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushEventQueue not implemented")
+}
+func (UnimplementedOneBotServer) GetEventsSince(context.Context, *GetEventsSinceRequest) (*GetEventsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsSince not implemented")
+}
+func (UnimplementedOneBotServer) mustEmbedUnimplementedOneBotServer() {}
+
+// UnsafeOneBotServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OneBotServer will
+// result in compilation errors.
+type UnsafeOneBotServer interface {
+	mustEmbedUnimplementedOneBotServer()
+}
+
+func RegisterOneBotServer(s grpc.ServiceRegistrar, srv OneBotServer) {
+	s.RegisterService(&OneBot_ServiceDesc, srv)
+}
+
+func _OneBot_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OneBotServer).SubscribeEvents(m, &oneBotSubscribeEventsServer{stream})
+}
+
+type OneBot_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type oneBotSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *oneBotSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OneBot_GetLoginInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetLoginInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetLoginInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetLoginInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_QidianGetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).QidianGetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/QidianGetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).QidianGetAccountInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetFriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetFriendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetFriendList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetFriendList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DeleteFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DeleteFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DeleteFriend(ctx, req.(*DeleteFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupList(ctx, req.(*GetGroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupInfo(ctx, req.(*GetGroupInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupMemberList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupMemberList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupMemberList(ctx, req.(*GetGroupMemberListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupMemberInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupMemberInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupMemberInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupMemberInfo(ctx, req.(*GetGroupMemberInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetStrangerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrangerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetStrangerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetStrangerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetStrangerInfo(ctx, req.(*GetStrangerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetVipInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetVipInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetVipInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetVipInfo(ctx, req.(*GetVipInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SendMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SendMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SendMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SendMsg(ctx, req.(*SendMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SendGroupMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SendGroupMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SendGroupMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SendGroupMsg(ctx, req.(*SendGroupMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SendPrivateMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPrivateMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SendPrivateMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SendPrivateMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SendPrivateMsg(ctx, req.(*SendPrivateMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SendGroupForwardMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupForwardMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SendGroupForwardMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SendGroupForwardMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SendGroupForwardMsg(ctx, req.(*SendGroupForwardMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DeleteMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DeleteMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DeleteMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DeleteMsg(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetMsg(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetForwardMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForwardMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetForwardMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetForwardMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetForwardMsg(ctx, req.(*GetForwardMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupMsgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupMsgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupMsgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupMsgHistory(ctx, req.(*GetGroupMsgHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetEssenceMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetEssenceMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetEssenceMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetEssenceMsg(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DeleteEssenceMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DeleteEssenceMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DeleteEssenceMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DeleteEssenceMsg(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetEssenceMsgList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetEssenceMsgList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetEssenceMsgList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetEssenceMsgList(ctx, req.(*GroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetFriendAddRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendAddRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetFriendAddRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetFriendAddRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetFriendAddRequest(ctx, req.(*SetFriendAddRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupAddRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAddRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupAddRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupAddRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupAddRequest(ctx, req.(*SetGroupAddRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupSystemMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupSystemMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupSystemMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupSystemMsg(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupCard(ctx, req.(*SetGroupCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupSpecialTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupSpecialTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupSpecialTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupSpecialTitle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupSpecialTitle(ctx, req.(*SetGroupSpecialTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupKick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupKickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupKick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupKick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupKick(ctx, req.(*SetGroupKickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupBan(ctx, req.(*SetGroupBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupWholeBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupWholeBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupWholeBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupWholeBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupWholeBan(ctx, req.(*SetGroupWholeBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupAnonymousBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAnonymousBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupAnonymousBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupAnonymousBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupAnonymousBan(ctx, req.(*SetGroupAnonymousBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupName(ctx, req.(*SetGroupNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupAdmin(ctx, req.(*SetGroupAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupPortrait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupPortraitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupPortrait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupPortrait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupPortrait(ctx, req.(*SetGroupPortraitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetGroupLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetGroupLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetGroupLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetGroupLeave(ctx, req.(*GroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SendGroupNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupNoticeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SendGroupNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SendGroupNotice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SendGroupNotice(ctx, req.(*SendGroupNoticeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupHonorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupHonorInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupHonorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupHonorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupHonorInfo(ctx, req.(*GetGroupHonorInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupAtAllRemain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupAtAllRemain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupAtAllRemain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupAtAllRemain(ctx, req.(*GroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupFileSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupFileSystemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupFileSystemInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupFileSystemInfo(ctx, req.(*GroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupRootFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupRootFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupRootFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupRootFiles(ctx, req.(*GroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupFilesByFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupFilesByFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupFilesByFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupFilesByFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupFilesByFolder(ctx, req.(*GetGroupFilesByFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetGroupFileUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupFileUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetGroupFileUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetGroupFileUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetGroupFileUrl(ctx, req.(*GetGroupFileUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_UploadGroupFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadGroupFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).UploadGroupFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/UploadGroupFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).UploadGroupFile(ctx, req.(*UploadGroupFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_CreateGroupFileFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupFileFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).CreateGroupFileFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/CreateGroupFileFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).CreateGroupFileFolder(ctx, req.(*CreateGroupFileFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DeleteGroupFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DeleteGroupFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DeleteGroupFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DeleteGroupFolder(ctx, req.(*DeleteGroupFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DeleteGroupFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DeleteGroupFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DeleteGroupFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DeleteGroupFile(ctx, req.(*DeleteGroupFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetImage(ctx, req.(*GetImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_DownloadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).DownloadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/DownloadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).DownloadFile(ctx, req.(*DownloadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_OcrImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OcrImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).OcrImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/OcrImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).OcrImage(ctx, req.(*OcrImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_CanSendImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).CanSendImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/CanSendImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).CanSendImage(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_CanSendRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).CanSendRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/CanSendRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).CanSendRecord(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetWordSlices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWordSlicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetWordSlices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetWordSlices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetWordSlices(ctx, req.(*GetWordSlicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_CheckUrlSafely_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUrlSafelyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).CheckUrlSafely(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/CheckUrlSafely",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).CheckUrlSafely(ctx, req.(*CheckUrlSafelyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetModelShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetModelShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetModelShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetModelShow(ctx, req.(*GetModelShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetModelShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModelShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetModelShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetModelShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetModelShow(ctx, req.(*SetModelShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetOnlineClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnlineClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetOnlineClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetOnlineClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetOnlineClients(ctx, req.(*GetOnlineClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_HandleQuickOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleQuickOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).HandleQuickOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/HandleQuickOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).HandleQuickOperation(ctx, req.(*HandleQuickOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetVersionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetVersionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetVersionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetVersionInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SetRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SetRestart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SetRestart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SetRestart(ctx, req.(*SetRestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).ReloadConfig(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_ReloadEventFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).ReloadEventFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/ReloadEventFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).ReloadEventFilter(ctx, req.(*ReloadEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_TestEventFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestEventFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).TestEventFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/TestEventFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).TestEventFilter(ctx, req.(*TestEventFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_SubmitLoginChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitLoginChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).SubmitLoginChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/SubmitLoginChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).SubmitLoginChallenge(ctx, req.(*SubmitLoginChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetTaskResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetTaskResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetTaskResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetTaskResult(ctx, req.(*GetTaskResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetPendingEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetPendingEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetPendingEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetPendingEvents(ctx, req.(*GetPendingEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_FlushEventQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushEventQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).FlushEventQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/FlushEventQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).FlushEventQueue(ctx, req.(*FlushEventQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OneBot_GetEventsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OneBotServer).GetEventsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onebot.OneBot/GetEventsSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OneBotServer).GetEventsSince(ctx, req.(*GetEventsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OneBot_ServiceDesc is the grpc.ServiceDesc for OneBot service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OneBot_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "onebot.OneBot",
+	HandlerType: (*OneBotServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLoginInfo",
+			Handler:    _OneBot_GetLoginInfo_Handler,
+		},
+		{
+			MethodName: "QidianGetAccountInfo",
+			Handler:    _OneBot_QidianGetAccountInfo_Handler,
+		},
+		{
+			MethodName: "GetFriendList",
+			Handler:    _OneBot_GetFriendList_Handler,
+		},
+		{
+			MethodName: "DeleteFriend",
+			Handler:    _OneBot_DeleteFriend_Handler,
+		},
+		{
+			MethodName: "GetGroupList",
+			Handler:    _OneBot_GetGroupList_Handler,
+		},
+		{
+			MethodName: "GetGroupInfo",
+			Handler:    _OneBot_GetGroupInfo_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberList",
+			Handler:    _OneBot_GetGroupMemberList_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberInfo",
+			Handler:    _OneBot_GetGroupMemberInfo_Handler,
+		},
+		{
+			MethodName: "GetStrangerInfo",
+			Handler:    _OneBot_GetStrangerInfo_Handler,
+		},
+		{
+			MethodName: "GetVipInfo",
+			Handler:    _OneBot_GetVipInfo_Handler,
+		},
+		{
+			MethodName: "SendMsg",
+			Handler:    _OneBot_SendMsg_Handler,
+		},
+		{
+			MethodName: "SendGroupMsg",
+			Handler:    _OneBot_SendGroupMsg_Handler,
+		},
+		{
+			MethodName: "SendPrivateMsg",
+			Handler:    _OneBot_SendPrivateMsg_Handler,
+		},
+		{
+			MethodName: "SendGroupForwardMsg",
+			Handler:    _OneBot_SendGroupForwardMsg_Handler,
+		},
+		{
+			MethodName: "DeleteMsg",
+			Handler:    _OneBot_DeleteMsg_Handler,
+		},
+		{
+			MethodName: "GetMsg",
+			Handler:    _OneBot_GetMsg_Handler,
+		},
+		{
+			MethodName: "GetForwardMsg",
+			Handler:    _OneBot_GetForwardMsg_Handler,
+		},
+		{
+			MethodName: "GetGroupMsgHistory",
+			Handler:    _OneBot_GetGroupMsgHistory_Handler,
+		},
+		{
+			MethodName: "SetEssenceMsg",
+			Handler:    _OneBot_SetEssenceMsg_Handler,
+		},
+		{
+			MethodName: "DeleteEssenceMsg",
+			Handler:    _OneBot_DeleteEssenceMsg_Handler,
+		},
+		{
+			MethodName: "GetEssenceMsgList",
+			Handler:    _OneBot_GetEssenceMsgList_Handler,
+		},
+		{
+			MethodName: "SetFriendAddRequest",
+			Handler:    _OneBot_SetFriendAddRequest_Handler,
+		},
+		{
+			MethodName: "SetGroupAddRequest",
+			Handler:    _OneBot_SetGroupAddRequest_Handler,
+		},
+		{
+			MethodName: "GetGroupSystemMsg",
+			Handler:    _OneBot_GetGroupSystemMsg_Handler,
+		},
+		{
+			MethodName: "SetGroupCard",
+			Handler:    _OneBot_SetGroupCard_Handler,
+		},
+		{
+			MethodName: "SetGroupSpecialTitle",
+			Handler:    _OneBot_SetGroupSpecialTitle_Handler,
+		},
+		{
+			MethodName: "SetGroupKick",
+			Handler:    _OneBot_SetGroupKick_Handler,
+		},
+		{
+			MethodName: "SetGroupBan",
+			Handler:    _OneBot_SetGroupBan_Handler,
+		},
+		{
+			MethodName: "SetGroupWholeBan",
+			Handler:    _OneBot_SetGroupWholeBan_Handler,
+		},
+		{
+			MethodName: "SetGroupAnonymousBan",
+			Handler:    _OneBot_SetGroupAnonymousBan_Handler,
+		},
+		{
+			MethodName: "SetGroupName",
+			Handler:    _OneBot_SetGroupName_Handler,
+		},
+		{
+			MethodName: "SetGroupAdmin",
+			Handler:    _OneBot_SetGroupAdmin_Handler,
+		},
+		{
+			MethodName: "SetGroupPortrait",
+			Handler:    _OneBot_SetGroupPortrait_Handler,
+		},
+		{
+			MethodName: "SetGroupLeave",
+			Handler:    _OneBot_SetGroupLeave_Handler,
+		},
+		{
+			MethodName: "SendGroupNotice",
+			Handler:    _OneBot_SendGroupNotice_Handler,
+		},
+		{
+			MethodName: "GetGroupHonorInfo",
+			Handler:    _OneBot_GetGroupHonorInfo_Handler,
+		},
+		{
+			MethodName: "GetGroupAtAllRemain",
+			Handler:    _OneBot_GetGroupAtAllRemain_Handler,
+		},
+		{
+			MethodName: "GetGroupFileSystemInfo",
+			Handler:    _OneBot_GetGroupFileSystemInfo_Handler,
+		},
+		{
+			MethodName: "GetGroupRootFiles",
+			Handler:    _OneBot_GetGroupRootFiles_Handler,
+		},
+		{
+			MethodName: "GetGroupFilesByFolder",
+			Handler:    _OneBot_GetGroupFilesByFolder_Handler,
+		},
+		{
+			MethodName: "GetGroupFileUrl",
+			Handler:    _OneBot_GetGroupFileUrl_Handler,
+		},
+		{
+			MethodName: "UploadGroupFile",
+			Handler:    _OneBot_UploadGroupFile_Handler,
+		},
+		{
+			MethodName: "CreateGroupFileFolder",
+			Handler:    _OneBot_CreateGroupFileFolder_Handler,
+		},
+		{
+			MethodName: "DeleteGroupFolder",
+			Handler:    _OneBot_DeleteGroupFolder_Handler,
+		},
+		{
+			MethodName: "DeleteGroupFile",
+			Handler:    _OneBot_DeleteGroupFile_Handler,
+		},
+		{
+			MethodName: "GetImage",
+			Handler:    _OneBot_GetImage_Handler,
+		},
+		{
+			MethodName: "DownloadFile",
+			Handler:    _OneBot_DownloadFile_Handler,
+		},
+		{
+			MethodName: "OcrImage",
+			Handler:    _OneBot_OcrImage_Handler,
+		},
+		{
+			MethodName: "CanSendImage",
+			Handler:    _OneBot_CanSendImage_Handler,
+		},
+		{
+			MethodName: "CanSendRecord",
+			Handler:    _OneBot_CanSendRecord_Handler,
+		},
+		{
+			MethodName: "GetWordSlices",
+			Handler:    _OneBot_GetWordSlices_Handler,
+		},
+		{
+			MethodName: "CheckUrlSafely",
+			Handler:    _OneBot_CheckUrlSafely_Handler,
+		},
+		{
+			MethodName: "GetModelShow",
+			Handler:    _OneBot_GetModelShow_Handler,
+		},
+		{
+			MethodName: "SetModelShow",
+			Handler:    _OneBot_SetModelShow_Handler,
+		},
+		{
+			MethodName: "GetOnlineClients",
+			Handler:    _OneBot_GetOnlineClients_Handler,
+		},
+		{
+			MethodName: "HandleQuickOperation",
+			Handler:    _OneBot_HandleQuickOperation_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _OneBot_GetStatus_Handler,
+		},
+		{
+			MethodName: "GetVersionInfo",
+			Handler:    _OneBot_GetVersionInfo_Handler,
+		},
+		{
+			MethodName: "SetRestart",
+			Handler:    _OneBot_SetRestart_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _OneBot_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "ReloadEventFilter",
+			Handler:    _OneBot_ReloadEventFilter_Handler,
+		},
+		{
+			MethodName: "TestEventFilter",
+			Handler:    _OneBot_TestEventFilter_Handler,
+		},
+		{
+			MethodName: "SubmitLoginChallenge",
+			Handler:    _OneBot_SubmitLoginChallenge_Handler,
+		},
+		{
+			MethodName: "GetTaskResult",
+			Handler:    _OneBot_GetTaskResult_Handler,
+		},
+		{
+			MethodName: "GetPendingEvents",
+			Handler:    _OneBot_GetPendingEvents_Handler,
+		},
+		{
+			MethodName: "FlushEventQueue",
+			Handler:    _OneBot_FlushEventQueue_Handler,
+		},
+		{
+			MethodName: "GetEventsSince",
+			Handler:    _OneBot_GetEventsSince_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _OneBot_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "onebot.proto",
+}
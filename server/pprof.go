@@ -4,18 +4,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
-	"os"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/Mrs4s/go-cqhttp/global/config"
 )
 
-// RunPprofServer 启动 pprof 性能分析服务器
-func RunPprofServer(conf *config.PprofServer) {
+// RunPprofServer 启动 pprof 性能分析服务器, 返回的函数用于停止该服务器, 监听失败时返回 nil
+func RunPprofServer(conf *config.PprofServer) (stop func()) {
 	if conf.Disabled {
-		return
+		return func() {}
 	}
 	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
 	mux := http.NewServeMux()
@@ -25,15 +23,19 @@ func RunPprofServer(conf *config.PprofServer) {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	server := http.Server{Addr: addr, Handler: mux}
+	lis, err := listen("pprof 服务", addr)
+	if err != nil {
+		return nil
+	}
 	go func() {
 		log.Infof("pprof debug 服务器已启动: %v/debug/pprof", addr)
 		log.Warnf("警告: pprof 服务不支持鉴权, 请不要运行在公网.")
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Error(err)
-			log.Infof("pprof 服务启动失败, 请检查端口是否被占用.")
-			log.Warnf("将在五秒后退出.")
-			time.Sleep(time.Second * 5)
-			os.Exit(1)
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("pprof debug 服务器 %v 出现错误: %v", addr, err)
 		}
 	}()
+	return func() {
+		_ = server.Close()
+		log.Infof("pprof debug 服务器已停止: %v", addr)
+	}
 }
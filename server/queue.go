@@ -0,0 +1,289 @@
+package server
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
+)
+
+var (
+	eventQueues     = make(map[string]*eventQueue)
+	eventQueueMutex sync.RWMutex
+)
+
+// eventQueue 基于 leveldb 的持久化上报队列
+//
+// 事件先写入队列, 再由单独的协程按顺序投递, 投递失败时以指数退避重试,
+// 因此上报目标重启期间的事件不会丢失.
+type eventQueue struct {
+	uin        int64
+	addr       string
+	db         *leveldb.DB
+	send       func([]byte) error
+	maxSize    uint64
+	maxBackoff time.Duration
+
+	lock       sync.Mutex
+	head, tail uint64 // 队首序号, 下一个写入的序号
+	notify     chan struct{}
+	flush      chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+// openEventQueue 打开账号 bot 上报到 addr 的队列, 队列文件保存在账号数据目录的 queue 目录下
+func openEventQueue(bot *coolq.CQBot, addr string, maxSize int, maxBackoff time.Duration, send func([]byte) error) (*eventQueue, error) {
+	hash := md5.Sum([]byte(addr))
+	p := path.Join(bot.DataDir(), "queue", hex.EncodeToString(hash[:]))
+	return newEventQueue(p, bot.Client.Uin, addr, maxSize, maxBackoff, send)
+}
+
+// newEventQueue 打开保存在 p 的队列并开始投递
+func newEventQueue(p string, uin int64, addr string, maxSize int, maxBackoff time.Duration, send func([]byte) error) (*eventQueue, error) {
+	db, err := leveldb.OpenFile(p, &opt.Options{WriteBuffer: 64 * opt.KiB})
+	if err != nil {
+		return nil, errors.Wrapf(err, "open event queue %v error", p)
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = time.Minute
+	}
+	q := &eventQueue{
+		uin:        uin,
+		addr:       addr,
+		db:         db,
+		send:       send,
+		maxSize:    uint64(maxSize),
+		maxBackoff: maxBackoff,
+		notify:     make(chan struct{}, 1),
+		flush:      make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	iter := db.NewIterator(nil, nil)
+	if iter.First() {
+		q.head = binary.BigEndian.Uint64(iter.Key())
+		iter.Last()
+		q.tail = binary.BigEndian.Uint64(iter.Key()) + 1
+	}
+	iter.Release()
+	if q.tail > q.head {
+		log.Infof("上报队列 %v 中有 %v 个未投递的事件, 将重新投递.", addr, q.tail-q.head)
+	}
+
+	eventQueueMutex.Lock()
+	eventQueues[queueID(q.uin, addr)] = q
+	eventQueueMutex.Unlock()
+	go q.run()
+	return q, nil
+}
+
+func queueKey(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return b
+}
+
+// push 将事件写入队尾
+func (q *eventQueue) push(data []byte) {
+	q.lock.Lock()
+	if q.maxSize > 0 && q.tail-q.head >= q.maxSize {
+		log.Warnf("上报队列 %v 已满, 将丢弃最早的事件.", q.addr)
+		metrics.HTTPPostFailures.Inc(q.addr)
+		_ = q.db.Delete(queueKey(q.head), nil)
+		q.head++
+	}
+	if err := q.db.Put(queueKey(q.tail), data, nil); err != nil {
+		q.lock.Unlock()
+		log.Warnf("写入上报队列 %v 时出现错误: %v", q.addr, err)
+		return
+	}
+	q.tail++
+	q.lock.Unlock()
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// peek 返回队首事件
+func (q *eventQueue) peek() (uint64, []byte, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for q.head < q.tail {
+		data, err := q.db.Get(queueKey(q.head), nil)
+		if err == nil {
+			return q.head, data, true
+		}
+		q.head++ // 已损坏或被清除的记录
+	}
+	return 0, nil, false
+}
+
+// pop 在投递成功后移除队首事件
+func (q *eventQueue) pop(seq uint64) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	_ = q.db.Delete(queueKey(seq), nil)
+	if q.head == seq {
+		q.head++
+	}
+}
+
+func (q *eventQueue) run() {
+	defer close(q.done)
+	var backoff time.Duration
+	for {
+		seq, data, ok := q.peek()
+		if !ok {
+			select {
+			case <-q.notify:
+			case <-q.stop:
+				return
+			}
+			continue
+		}
+		if err := q.send(data); err != nil {
+			if backoff == 0 {
+				backoff = time.Second
+			} else if backoff *= 2; backoff > q.maxBackoff {
+				backoff = q.maxBackoff
+			}
+			log.Warnf("上报Event到 HTTP 服务器 %v 时出现错误: %v 将在 %v 后重试.", q.addr, err, backoff)
+			metrics.HTTPPostRetries.Inc(q.addr)
+			select {
+			case <-time.After(backoff):
+			case <-q.flush:
+				backoff = 0
+			case <-q.stop:
+				return
+			}
+			continue
+		}
+		backoff = 0
+		q.pop(seq)
+		select {
+		case <-q.stop:
+			return
+		default:
+		}
+	}
+}
+
+// close 停止投递并关闭队列, 未投递的事件将保留到下次打开
+func (q *eventQueue) close() {
+	eventQueueMutex.Lock()
+	if eventQueues[queueID(q.uin, q.addr)] == q {
+		delete(eventQueues, queueID(q.uin, q.addr))
+	}
+	eventQueueMutex.Unlock()
+	close(q.stop)
+	<-q.done
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	_ = q.db.Close()
+}
+
+// pending 返回队列长度与最早的 limit 个事件
+func (q *eventQueue) pending(limit int) (uint64, []coolq.MSG) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	events := make([]coolq.MSG, 0, limit)
+	for seq := q.head; seq < q.tail && len(events) < limit; seq++ {
+		data, err := q.db.Get(queueKey(seq), nil)
+		if err != nil {
+			continue
+		}
+		m := coolq.MSG{}
+		if json.Unmarshal(data, &m) == nil {
+			events = append(events, m)
+		}
+	}
+	return q.tail - q.head, events
+}
+
+// retryNow 跳过当前的退避等待, 立即重新投递
+func (q *eventQueue) retryNow() {
+	select {
+	case q.flush <- struct{}{}:
+	default:
+	}
+}
+
+// clear 丢弃队列中所有未投递的事件
+func (q *eventQueue) clear() uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	n := q.tail - q.head
+	batch := new(leveldb.Batch)
+	for seq := q.head; seq < q.tail; seq++ {
+		batch.Delete(queueKey(seq))
+	}
+	_ = q.db.Write(batch, nil)
+	q.head = q.tail
+	return n
+}
+
+func queueID(uin int64, addr string) string {
+	return strconv.FormatInt(uin, 10) + ":" + addr
+}
+
+// findEventQueues 返回账号 uin 上报到 addr 的队列, addr 为空时返回该账号的全部队列
+func findEventQueues(uin int64, addr string) []*eventQueue {
+	eventQueueMutex.RLock()
+	defer eventQueueMutex.RUnlock()
+	var ret []*eventQueue
+	for _, q := range eventQueues {
+		if q.uin == uin && (addr == "" || q.addr == addr) {
+			ret = append(ret, q)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].addr < ret[j].addr })
+	return ret
+}
+
+func getPendingEvents(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	limit := int(p.Get("limit").Int())
+	if limit <= 0 {
+		limit = 100
+	}
+	queues := findEventQueues(bot.Client.Uin, p.Get("url").String())
+	ret := make([]coolq.MSG, 0, len(queues))
+	for _, q := range queues {
+		count, events := q.pending(limit)
+		ret = append(ret, coolq.MSG{
+			"url":    q.addr,
+			"count":  count,
+			"events": events,
+		})
+	}
+	return coolq.OK(ret)
+}
+
+func flushEventQueue(bot *coolq.CQBot, p resultGetter) coolq.MSG {
+	queues := findEventQueues(bot.Client.Uin, p.Get("url").String())
+	if len(queues) == 0 {
+		return coolq.Failed(100, "QUEUE_NOT_FOUND", "上报队列不存在")
+	}
+	var dropped uint64
+	for _, q := range queues {
+		if global.EnsureBool(p.Get("clear"), false) {
+			dropped += q.clear()
+		}
+		q.retryNow()
+	}
+	return coolq.OK(coolq.MSG{"dropped": dropped})
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder 记录投递的事件, 前 fails 次投递返回错误
+type recorder struct {
+	lock      sync.Mutex
+	fails     int
+	attempts  int
+	delivered []string
+}
+
+func (r *recorder) send(data []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.attempts++
+	if r.attempts <= r.fails {
+		return errors.New("502 Bad Gateway")
+	}
+	r.delivered = append(r.delivered, string(data))
+	return nil
+}
+
+func (r *recorder) result() (int, []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.attempts, append([]string(nil), r.delivered...)
+}
+
+func TestEventQueue(t *testing.T) {
+	var tests = [...]struct {
+		name     string
+		fails    int
+		attempts int
+	}{
+		{"no failure", 0, 3},
+		{"redeliver after failure", 1, 4},
+		{"redeliver after repeated failures", 3, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{fails: tt.fails}
+			q, err := newEventQueue(t.TempDir(), 1, "http://127.0.0.1", 0, time.Minute, r.send)
+			assert.NoError(t, err)
+			defer q.close()
+			for _, e := range []string{"1", "2", "3"} {
+				q.push([]byte(e))
+			}
+			assert.Eventually(t, func() bool {
+				q.retryNow() // 跳过退避等待
+				_, delivered := r.result()
+				return len(delivered) == 3
+			}, time.Second*5, time.Millisecond*10)
+			attempts, delivered := r.result()
+			assert.Equal(t, []string{"1", "2", "3"}, delivered)
+			assert.Equal(t, tt.attempts, attempts)
+		})
+	}
+}
+
+func TestEventQueueReopen(t *testing.T) {
+	dir := t.TempDir()
+	r := &recorder{fails: 1 << 30}
+	q, err := newEventQueue(dir, 1, "http://127.0.0.1", 2, time.Minute, r.send)
+	assert.NoError(t, err)
+	for _, e := range []string{"1", "2", "3"} {
+		q.push([]byte(e))
+	}
+	count, _ := q.pending(10)
+	assert.Equal(t, uint64(2), count) // 队列已满时丢弃最早的事件
+	q.close()
+
+	r = &recorder{}
+	q, err = newEventQueue(dir, 1, "http://127.0.0.1", 2, time.Minute, r.send)
+	assert.NoError(t, err)
+	defer q.close()
+	assert.Eventually(t, func() bool {
+		_, delivered := r.result()
+		return len(delivered) == 2
+	}, time.Second*5, time.Millisecond*10)
+	_, delivered := r.result()
+	assert.Equal(t, []string{"2", "3"}, delivered)
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"crypto/x509"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// tokenScope 访问令牌的权限范围, nil 表示不受限制
+type tokenScope struct {
+	allowActions []string
+	denyActions  []string
+	allowGroups  map[int64]struct{}
+	denyGroups   map[int64]struct{}
+}
+
+// accessTokens 服务器接受的访问令牌, token -> 权限范围
+type accessTokens struct {
+	scopes  map[string]*tokenScope
+	certs   map[string]*tokenScope // 客户端证书的 CommonName 或 DNS 名称 -> 权限范围
+	primary string
+}
+
+// newAccessTokens 根据中间件配置创建访问令牌集合, 未配置任何令牌时返回 nil
+func newAccessTokens(conf *config.MiddleWares) *accessTokens {
+	t := &accessTokens{scopes: make(map[string]*tokenScope), certs: make(map[string]*tokenScope)}
+	if conf.AccessToken != "" {
+		t.scopes[conf.AccessToken] = nil
+		t.primary = conf.AccessToken
+	}
+	for _, at := range conf.AccessTokens {
+		scope := newTokenScope(&at)
+		for _, name := range at.ClientCerts {
+			t.certs[name] = scope
+		}
+		if at.Token == "" {
+			continue
+		}
+		t.scopes[at.Token] = scope
+		if t.primary == "" {
+			t.primary = at.Token
+		}
+	}
+	if len(t.scopes) == 0 && len(t.certs) == 0 {
+		return nil
+	}
+	return t
+}
+
+func newTokenScope(at *config.AccessToken) *tokenScope {
+	if len(at.AllowActions) == 0 && len(at.DenyActions) == 0 && len(at.AllowGroups) == 0 && len(at.DenyGroups) == 0 {
+		return nil
+	}
+	s := &tokenScope{
+		allowActions: at.AllowActions,
+		denyActions:  at.DenyActions,
+	}
+	if len(at.AllowGroups) > 0 {
+		s.allowGroups = make(map[int64]struct{}, len(at.AllowGroups))
+		for _, g := range at.AllowGroups {
+			s.allowGroups[g] = struct{}{}
+		}
+	}
+	if len(at.DenyGroups) > 0 {
+		s.denyGroups = make(map[int64]struct{}, len(at.DenyGroups))
+		for _, g := range at.DenyGroups {
+			s.denyGroups[g] = struct{}{}
+		}
+	}
+	return s
+}
+
+// token 返回主动连接时使用的令牌
+func (t *accessTokens) token() string {
+	if t == nil {
+		return ""
+	}
+	return t.primary
+}
+
+// scope 返回令牌对应的权限范围
+func (t *accessTokens) scope(token string) *tokenScope {
+	if t == nil {
+		return nil
+	}
+	return t.scopes[token]
+}
+
+func matchAction(patterns []string, action string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, action); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// messageActions 通过 message_id 操作消息的 API, 按消息所属的群检查群限制
+var messageActions = map[string]struct{}{
+	"delete_msg":         {},
+	"get_msg":            {},
+	"set_essence_msg":    {},
+	"delete_essence_msg": {},
+}
+
+// groupUnknownActions 无法在调用前确定所操作的群的 API, 设置了群限制的令牌无权调用
+var groupUnknownActions = map[string]struct{}{
+	"set_group_add_request":   {}, // 按 flag 处理请求
+	"get_group_system_msg":    {}, // 返回所有群的请求
+	".handle_quick_operation": {}, // context 由调用方提供
+}
+
+// check 检查令牌是否有权调用 action, 无权调用时返回错误结果
+//
+// bot 为调用的账号, 用于查找 message_id 所属的群, 为 nil 时不检查消息.
+func (s *tokenScope) check(bot *coolq.CQBot, action string, p resultGetter) coolq.MSG {
+	if s == nil {
+		return nil
+	}
+	if matchAction(s.denyActions, action) || (len(s.allowActions) > 0 && !matchAction(s.allowActions, action)) {
+		return coolq.Failed(403, "ACTION_FORBIDDEN", "无权调用该API")
+	}
+	if s.allowGroups == nil && s.denyGroups == nil {
+		return nil
+	}
+	if _, ok := groupUnknownActions[action]; ok {
+		return coolq.Failed(403, "GROUP_FORBIDDEN", "设置了群限制的令牌无权调用该API")
+	}
+	gid, ok := targetGroup(bot, action, p)
+	if !ok {
+		return nil
+	}
+	if !s.allowGroup(gid) {
+		return coolq.Failed(403, "GROUP_FORBIDDEN", "无权操作该群")
+	}
+	return nil
+}
+
+// allowGroup 是否允许操作群 gid, gid 为 0 表示无法确定所属的群
+func (s *tokenScope) allowGroup(gid int64) bool {
+	if gid == 0 {
+		return false
+	}
+	if _, ok := s.denyGroups[gid]; ok {
+		return false
+	}
+	_, ok := s.allowGroups[gid]
+	return s.allowGroups == nil || ok
+}
+
+// targetGroup 返回 API 操作的群, 不操作群时返回 false
+//
+// 通过 message_id 操作的消息不存在时返回 0, 以免绕过群限制.
+func targetGroup(bot *coolq.CQBot, action string, p resultGetter) (int64, bool) {
+	if g := p.Get("group_id"); g.Exists() {
+		return g.Int(), true
+	}
+	if _, ok := messageActions[action]; !ok || bot == nil {
+		return 0, false
+	}
+	msg := bot.GetMessage(int32(p.Get("message_id").Int()))
+	if msg == nil {
+		return 0, true
+	}
+	g, ok := msg["group"]
+	if !ok {
+		return 0, false // 私聊消息
+	}
+	gid, _ := g.(int64)
+	return gid, true
+}
+
+// checkAuth 校验请求携带的客户端证书或访问令牌, 返回对应的 HTTP 状态码与权限范围
+//
+// 客户端证书不在 client-certs 中时继续校验访问令牌.
+func checkAuth(req *http.Request, tokens *accessTokens) (int, *tokenScope) {
+	if tokens == nil { // quick path
+		return http.StatusOK, nil
+	}
+	if cert := verifiedClientCert(req); cert != nil {
+		if scope, ok := tokens.certScope(cert); ok {
+			return http.StatusOK, scope
+		}
+	}
+	auth := req.Header.Get("Authorization")
+	if auth == "" {
+		auth = req.URL.Query().Get("access_token")
+	} else {
+		auth = bearerToken(auth)
+	}
+	return tokens.verify(auth)
+}
+
+// certScope 返回客户端证书对应的权限范围, 按 CommonName 与 DNS 名称依次查找
+func (t *accessTokens) certScope(cert *x509.Certificate) (*tokenScope, bool) {
+	if scope, ok := t.certs[cert.Subject.CommonName]; ok && cert.Subject.CommonName != "" {
+		return scope, true
+	}
+	for _, name := range cert.DNSNames {
+		if scope, ok := t.certs[name]; ok {
+			return scope, true
+		}
+	}
+	return nil, false
+}
+
+// bearerToken 返回 Authorization 请求头中的令牌
+func bearerToken(auth string) string {
+	authN := strings.SplitN(auth, " ", 2)
+	if len(authN) == 2 {
+		return authN[1]
+	}
+	return auth
+}
+
+// verify 校验令牌, 返回对应的 HTTP 状态码与权限范围
+func (t *accessTokens) verify(auth string) (int, *tokenScope) {
+	if t == nil {
+		return http.StatusOK, nil
+	}
+	if auth == "" {
+		return http.StatusUnauthorized, nil
+	}
+	scope, ok := t.scopes[auth]
+	if !ok {
+		return http.StatusForbidden, nil
+	}
+	return http.StatusOK, scope
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestTokenScopeCheck(t *testing.T) {
+	scope := newTokenScope(&config.AccessToken{
+		Token:        "t",
+		AllowActions: []string{"get_*", "send_*", "delete_msg", "set_group_*"},
+		DenyActions:  []string{"set_group_leave"},
+		AllowGroups:  []int64{1, 2},
+		DenyGroups:   []int64{2},
+	})
+	var tests = [...]struct {
+		action   string
+		params   string
+		expected interface{} // 为 nil 时允许调用, 否则为错误的 msg
+	}{
+		{"get_status", `{}`, nil},
+		{"send_group_msg", `{"group_id":1}`, nil},
+		{"send_group_msg", `{"group_id":"1"}`, nil},
+		{"send_group_msg", `{"group_id":2}`, "GROUP_FORBIDDEN"},
+		{"send_group_msg", `{"group_id":3}`, "GROUP_FORBIDDEN"},
+		{"send_private_msg", `{"user_id":3}`, nil},
+		{"send_private_msg", `{"user_id":3,"group_id":3}`, "GROUP_FORBIDDEN"},
+		{"set_group_leave", `{"group_id":1}`, "ACTION_FORBIDDEN"},
+		{"set_restart", `{}`, "ACTION_FORBIDDEN"},
+		{"set_group_add_request", `{"flag":"1"}`, "GROUP_FORBIDDEN"},
+		{"get_group_system_msg", `{}`, "GROUP_FORBIDDEN"},
+		{"delete_msg", `{"message_id":1}`, nil}, // 未指定账号时由调用返回 BOT_NOT_FOUND
+	}
+	for _, tt := range tests {
+		t.Run(tt.action+tt.params, func(t *testing.T) {
+			ret := scope.check(nil, tt.action, gjson.Parse(tt.params))
+			if tt.expected == nil {
+				assert.Nil(t, ret)
+				return
+			}
+			assert.Equal(t, 403, ret["retcode"])
+			assert.Equal(t, tt.expected, ret["msg"])
+		})
+	}
+	var unrestricted *tokenScope
+	assert.Nil(t, unrestricted.check(nil, "set_restart", gjson.Parse(`{}`)))
+}
+
+func TestAccessTokens(t *testing.T) {
+	tokens := newAccessTokens(&config.MiddleWares{
+		AccessToken:  "primary",
+		AccessTokens: []config.AccessToken{{Token: "scoped", AllowActions: []string{"get_*"}}, {Token: "plain"}},
+	})
+	assert.Equal(t, "primary", tokens.token())
+	var tests = [...]struct {
+		token  string
+		status int
+		scoped bool
+	}{
+		{"", http.StatusUnauthorized, false},
+		{"wrong", http.StatusForbidden, false},
+		{"primary", http.StatusOK, false},
+		{"plain", http.StatusOK, false},
+		{"scoped", http.StatusOK, true},
+	}
+	for _, tt := range tests {
+		status, scope := tokens.verify(tt.token)
+		assert.Equal(t, tt.status, status, tt.token)
+		assert.Equal(t, tt.scoped, scope != nil, tt.token)
+	}
+	assert.Nil(t, newAccessTokens(&config.MiddleWares{}))
+	assert.Equal(t, "Token", bearerToken("Token"))
+	assert.Equal(t, "Token", bearerToken("Bearer Token"))
+}
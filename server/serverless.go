@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// serverlessHandler Serverless 函数调用入口
+//
+// 每次调用执行一个 API 请求, 并在返回响应前等待事件上报完成,
+// 以免平台在响应结束后冻结进程导致事件丢失.
+type serverlessHandler struct {
+	bot          *coolq.CQBot
+	api          *httpServer
+	flushTimeout time.Duration
+}
+
+// bufferedResponseWriter 缓存响应内容, 直到事件上报完成后再写出
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponseWriter) writeTo(writer http.ResponseWriter) {
+	for k, v := range w.header {
+		writer.Header()[k] = v
+	}
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	writer.WriteHeader(w.status)
+	_, _ = writer.Write(w.body.Bytes())
+}
+
+// NewServerlessHandler 创建一个处理 Serverless 函数调用的 http.Handler
+func NewServerlessHandler(bot *coolq.CQBot, conf *config.ServerlessServer) http.Handler {
+	s := &httpServer{
+		api:    newAPICaller(bot),
+		tokens: newAccessTokens(&conf.MiddleWares),
+	}
+	s.api.v12 = isV12(conf.OneBotVersion)
+	if conf.RateLimit.Enabled {
+		s.api.use(rateLimit(conf.RateLimit.Frequency, conf.RateLimit.Bucket))
+	}
+	timeout := time.Millisecond * time.Duration(conf.FlushTimeout)
+	if timeout <= 0 {
+		timeout = time.Second * 3
+	}
+	return &serverlessHandler{
+		bot:          bot,
+		api:          s,
+		flushTimeout: timeout,
+	}
+}
+
+func (h *serverlessHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	w := &bufferedResponseWriter{header: http.Header{}}
+	h.api.ServeHTTP(w, request)
+	deadline := time.Now().Add(h.flushTimeout)
+	for _, b := range botsOf(h.bot) {
+		if !b.FlushEvents(time.Until(deadline)) {
+			log.Warnf("Serverless 调用结束前等待事件上报超时 (%v).", h.flushTimeout)
+			break
+		}
+	}
+	w.writeTo(writer)
+}
+
+// RunServerlessServer 启动 Serverless 函数调用入口, 返回的函数用于停止该入口, 监听失败时返回 nil
+func RunServerlessServer(bot *coolq.CQBot, conf *config.ServerlessServer) (stop func()) {
+	if conf.Disabled {
+		return func() {}
+	}
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	server := &http.Server{
+		Addr:    addr,
+		Handler: NewServerlessHandler(bot, conf),
+	}
+	lis, err := listen("Serverless 函数入口", addr)
+	if err != nil {
+		return nil
+	}
+	go func() {
+		log.Infof("Serverless 函数入口已启动: %v", addr)
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Serverless 函数入口 %v 出现错误: %v", addr, err)
+		}
+	}()
+	return func() {
+		_ = server.Close()
+		log.Infof("Serverless 函数入口已停止: %v", addr)
+	}
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guonaihong/gout"
+	"github.com/pkg/errors"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// hashFunc 返回签名算法名称与对应的哈希函数, 未设置时使用 sha1, 未知算法返回 false
+func hashFunc(algorithm string) (string, func() hash.Hash, bool) {
+	switch strings.ToLower(algorithm) {
+	case "", "sha1":
+		return "sha1", sha1.New, true
+	case "sha256":
+		return "sha256", sha256.New, true
+	case "sha512":
+		return "sha512", sha512.New, true
+	default:
+		return "sha1", sha1.New, false
+	}
+}
+
+// checkAlgorithm 检查配置中的签名算法, 以免拼写错误时静默使用其他算法
+func checkAlgorithm(algorithm string) error {
+	if _, _, ok := hashFunc(algorithm); !ok {
+		return errors.Errorf("未知的签名算法 %v, 可选 sha1, sha256, sha512", algorithm)
+	}
+	return nil
+}
+
+// signature 使用 secret 计算上报请求 body 的 HMAC 签名
+//
+// timestamp 不为空时签名内容为 "<timestamp>.<body>"
+func signature(algorithm, secret, timestamp string, body []byte) string {
+	prefix := ""
+	if timestamp != "" {
+		prefix = timestamp + "."
+	}
+	return hmacSign(algorithm, secret, prefix, body)
+}
+
+// requestSignature 计算 API 请求的签名, 签名内容为 "<method>\n<path>\n<timestamp>.<body>"
+//
+// 方法与路径一同签名, 以免截获的请求被重放到其他 API.
+func requestSignature(algorithm, secret, method, path, timestamp string, body []byte) string {
+	return hmacSign(algorithm, secret, method+"\n"+path+"\n"+timestamp+".", body)
+}
+
+func hmacSign(algorithm, secret, prefix string, body []byte) string {
+	name, h, _ := hashFunc(algorithm)
+	mac := hmac.New(h, []byte(secret))
+	_, _ = mac.Write([]byte(prefix))
+	_, _ = mac.Write(body)
+	return name + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// signHeader 为上报请求头添加签名, withTimestamp 为 true 时同时添加 X-Timestamp
+func signHeader(h gout.H, algorithm, secret string, withTimestamp bool, body []byte) {
+	if secret == "" {
+		return
+	}
+	var ts string
+	if withTimestamp {
+		ts = strconv.FormatInt(time.Now().Unix(), 10)
+		h["X-Timestamp"] = ts
+	}
+	h["X-Signature"] = signature(algorithm, secret, ts, body)
+}
+
+// signatureVerifier HTTP API 请求签名验证
+//
+// 请求需要携带 X-Timestamp 与 X-Signature, 时间戳超出 maxSkew 或
+// 签名在有效期内重复出现的请求将被拒绝.
+type signatureVerifier struct {
+	secret    string
+	algorithm string
+	maxSkew   time.Duration
+
+	lock sync.Mutex
+	seen map[string]int64 // signature -> timestamp
+}
+
+// newSignatureVerifier 创建签名验证器, 未设置 secret 时返回 nil, 签名算法未知时返回错误
+func newSignatureVerifier(conf *config.HTTPSignature) (*signatureVerifier, error) {
+	if conf.Secret == "" {
+		return nil, nil
+	}
+	if err := checkAlgorithm(conf.Algorithm); err != nil {
+		return nil, err
+	}
+	v := &signatureVerifier{
+		secret:    conf.Secret,
+		algorithm: conf.Algorithm,
+		maxSkew:   time.Second * time.Duration(conf.MaxSkew),
+		seen:      make(map[string]int64),
+	}
+	if v.algorithm == "" {
+		v.algorithm = "sha256"
+	}
+	if v.maxSkew <= 0 {
+		v.maxSkew = time.Minute * 5
+	}
+	return v, nil
+}
+
+// verify 验证请求签名, 返回 HTTP 状态码
+func (v *signatureVerifier) verify(req *http.Request, body []byte) int {
+	ts := req.Header.Get("X-Timestamp")
+	sig := req.Header.Get("X-Signature")
+	if ts == "" || sig == "" {
+		return http.StatusUnauthorized
+	}
+	t, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return http.StatusUnauthorized
+	}
+	now := time.Now().Unix()
+	skew := int64(v.maxSkew / time.Second)
+	if t < now-skew || t > now+skew {
+		return http.StatusForbidden
+	}
+	if !hmac.Equal([]byte(sig), []byte(requestSignature(v.algorithm, v.secret, req.Method, req.URL.Path, ts, body))) {
+		return http.StatusForbidden
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if _, ok := v.seen[sig]; ok {
+		return http.StatusForbidden
+	}
+	for k, seen := range v.seen {
+		if seen < now-skew {
+			delete(v.seen, k)
+		}
+	}
+	v.seen[sig] = t
+	return http.StatusOK
+}
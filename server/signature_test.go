@@ -0,0 +1,101 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestSignatureVerify(t *testing.T) {
+	const secret = "secret"
+	body := []byte(`{"group_id":1}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	sign := func(method, path, ts string) string {
+		return requestSignature("sha256", secret, method, path, ts, body)
+	}
+	var tests = [...]struct {
+		name      string
+		method    string
+		path      string
+		timestamp string
+		signature string
+		expected  int
+	}{
+		{"valid", http.MethodPost, "/send_group_msg", now, sign(http.MethodPost, "/send_group_msg", now), http.StatusOK},
+		{"missing timestamp", http.MethodPost, "/send_group_msg", "", sign(http.MethodPost, "/send_group_msg", now), http.StatusUnauthorized},
+		{"missing signature", http.MethodPost, "/send_group_msg", now, "", http.StatusUnauthorized},
+		{"invalid timestamp", http.MethodPost, "/send_group_msg", "now", sign(http.MethodPost, "/send_group_msg", "now"), http.StatusUnauthorized},
+		{"bad signature", http.MethodPost, "/send_group_msg", now, "sha256=00", http.StatusForbidden},
+		{"wrong algorithm", http.MethodPost, "/send_group_msg", now,
+			requestSignature("sha1", secret, http.MethodPost, "/send_group_msg", now, body), http.StatusForbidden},
+		{"other path", http.MethodPost, "/set_group_kick", now, sign(http.MethodPost, "/send_group_msg", now), http.StatusForbidden},
+		{"other method", http.MethodGet, "/send_group_msg", now, sign(http.MethodPost, "/send_group_msg", now), http.StatusForbidden},
+		{"expired", http.MethodPost, "/get_status", "1",
+			sign(http.MethodPost, "/get_status", "1"), http.StatusForbidden},
+		{"future", http.MethodPost, "/get_status", strconv.FormatInt(time.Now().Unix()+3600, 10),
+			sign(http.MethodPost, "/get_status", strconv.FormatInt(time.Now().Unix()+3600, 10)), http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := newSignatureVerifier(&config.HTTPSignature{Secret: secret, MaxSkew: 60})
+			assert.NoError(t, err)
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.timestamp != "" {
+				req.Header.Set("X-Timestamp", tt.timestamp)
+			}
+			if tt.signature != "" {
+				req.Header.Set("X-Signature", tt.signature)
+			}
+			assert.Equal(t, tt.expected, v.verify(req, body))
+		})
+	}
+}
+
+func TestSignatureReplay(t *testing.T) {
+	v, _ := newSignatureVerifier(&config.HTTPSignature{Secret: "secret"})
+	body := []byte(`{}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, "/get_status", nil)
+	req.Header.Set("X-Timestamp", now)
+	req.Header.Set("X-Signature", requestSignature("sha256", "secret", http.MethodPost, "/get_status", now, body))
+	assert.Equal(t, http.StatusOK, v.verify(req, body))
+	assert.Equal(t, http.StatusForbidden, v.verify(req, body))
+}
+
+func TestSignature(t *testing.T) {
+	// 上报请求的签名与 CQHTTP 兼容, 仅对请求体与可选的时间戳签名
+	assert.Equal(t, "sha1=1aa349585ed7ecbd3b9c486a30067e395ca4b356", signature("", "secret", "", []byte("test")))
+	assert.Equal(t, "sha256=8f60aa030d5bd1797d9ad89920e3aed4a6c7b9b9f662f0d879487e91bda6ff43", signature("sha256", "secret", "1", []byte("test")))
+}
+
+func TestCheckAlgorithm(t *testing.T) {
+	var tests = [...]struct {
+		algorithm string
+		valid     bool
+	}{
+		{"", true},
+		{"sha1", true},
+		{"SHA256", true},
+		{"sha512", true},
+		{"sha-256", false},
+		{"md5", false},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			assert.Equal(t, tests[i].valid, checkAlgorithm(tests[i].algorithm) == nil)
+		})
+	}
+
+	_, err := newSignatureVerifier(&config.HTTPSignature{Secret: "secret", Algorithm: "sha-256"})
+	assert.Error(t, err)
+	v, err := newSignatureVerifier(&config.HTTPSignature{Algorithm: "sha-256"})
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Nil(t, RunHTTPServerAndClients(nil, &config.HTTPServer{Post: []config.HTTPPost{{URL: "http://127.0.0.1:1", Secret: "secret", Algorithm: "sha-256"}}}))
+}
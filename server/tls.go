@@ -0,0 +1,156 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// certReloader 读取证书与 CA, 文件修改后在下一次握手时重新加载, 更新证书无需重启
+type certReloader struct {
+	conf *config.TLS
+
+	lock    sync.Mutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func newCertReloader(conf *config.TLS) (*certReloader, error) {
+	r := &certReloader{conf: conf}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// latestModTime 返回证书文件中最晚的修改时间
+func (r *certReloader) latestModTime() time.Time {
+	var t time.Time
+	for _, file := range []string{r.conf.Cert, r.conf.Key, r.conf.ClientCA} {
+		if file == "" {
+			continue
+		}
+		if info, err := os.Stat(file); err == nil && info.ModTime().After(t) {
+			t = info.ModTime()
+		}
+	}
+	return t
+}
+
+func (r *certReloader) load() error {
+	modTime := r.latestModTime()
+	var cert *tls.Certificate
+	if r.conf.Cert != "" || r.conf.Key != "" {
+		c, err := tls.LoadX509KeyPair(r.conf.Cert, r.conf.Key)
+		if err != nil {
+			return errors.Wrap(err, "load certificate error")
+		}
+		cert = &c
+	}
+	var pool *x509.CertPool
+	if r.conf.ClientCA != "" {
+		data, err := os.ReadFile(r.conf.ClientCA)
+		if err != nil {
+			return errors.Wrap(err, "read ca error")
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.Errorf("no certificate found in %v", r.conf.ClientCA)
+		}
+	}
+	r.cert, r.pool, r.modTime = cert, pool, modTime
+	return nil
+}
+
+// get 返回当前的证书与 CA, 重新加载失败时继续使用原有的证书
+func (r *certReloader) get() (*tls.Certificate, *x509.CertPool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if modTime := r.latestModTime(); !modTime.Equal(r.modTime) {
+		if err := r.load(); err != nil {
+			// 文件再次修改前不再重试, 以免每次握手都输出日志
+			r.modTime = modTime
+			log.Warnf("重新加载 TLS 证书失败, 将继续使用原有的证书: %v", err)
+		} else {
+			log.Infof("已重新加载 TLS 证书: %v", r.conf.Cert)
+		}
+	}
+	return r.cert, r.pool
+}
+
+// serverTLSConfig 创建 HTTP 与正向WS服务器使用的 TLS 配置, 未配置证书时返回 nil
+//
+// 配置 client-ca 后校验客户端提供的证书, 证书按 client-certs 对应到访问令牌的权限范围.
+// requireClientCert 为 true 时 (即未设置访问令牌) 拒绝未提供证书的客户端.
+func serverTLSConfig(conf *config.TLS, requireClientCert bool) (*tls.Config, error) {
+	if conf.Cert == "" && conf.Key == "" {
+		if conf.ClientCA != "" {
+			return nil, errors.New("client-ca requires cert and key")
+		}
+		return nil, nil
+	}
+	r, err := newCertReloader(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _ := r.get()
+			return cert, nil
+		},
+		// 每次握手使用最新的证书与 CA
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.get()
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"http/1.1"}, // WebSocket 不支持 HTTP/2
+			}
+			if pool != nil {
+				c.ClientCAs = pool
+				c.ClientAuth = tls.VerifyClientCertIfGiven
+				if requireClientCert {
+					c.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return c, nil
+		},
+	}, nil
+}
+
+// clientConfig 返回连接 wss 服务器时使用的 TLS 配置, 证书作为客户端证书, client-ca 用于校验服务器证书
+func (r *certReloader) clientConfig() *tls.Config {
+	cert, pool := r.get()
+	c := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}
+	if cert != nil {
+		c.Certificates = []tls.Certificate{*cert}
+	}
+	return c
+}
+
+// serve 在 lis 上提供服务, 配置了 TLS 时使用 HTTPS
+func serve(server *http.Server, lis net.Listener) error {
+	if server.TLSConfig != nil {
+		return server.ServeTLS(lis, "", "")
+	}
+	return server.Serve(lis)
+}
+
+// verifiedClientCert 返回请求中通过校验的客户端证书, 没有时返回 nil
+func verifiedClientCert(req *http.Request) *x509.Certificate {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return req.TLS.VerifiedChains[0][0]
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+// testCA 用于测试的 CA, 签发服务器与客户端证书
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	ca.write(t, "ca.pem", "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) write(t *testing.T, name, typ string, der []byte) string {
+	file := filepath.Join(ca.dir, name)
+	assert.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return file
+}
+
+// issue 签发证书, 返回证书与私钥的文件路径及 tls.Certificate
+func (ca *testCA) issue(t *testing.T, cn string, dnsNames ...string) (string, string, tls.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	certFile := ca.write(t, cn+".pem", "CERTIFICATE", der)
+	keyFile := ca.write(t, cn+".key", "EC PRIVATE KEY", keyDer)
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	assert.NoError(t, err)
+	return certFile, keyFile, pair
+}
+
+// runTLSServer 启动使用 conf 的 HTTPS 服务器, 响应 checkAuth 的结果
+func runTLSServer(t *testing.T, conf *config.TLS, tokens *accessTokens) string {
+	tlsConf, err := serverTLSConfig(conf, tokens == nil)
+	assert.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := &http.Server{TLSConfig: tlsConf, Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, scope := checkAuth(r, tokens)
+		w.WriteHeader(status)
+		_, _ = fmt.Fprint(w, scope != nil)
+	})}
+	go func() { _ = serve(server, lis) }()
+	t.Cleanup(func() { _ = server.Close() })
+	return "https://" + lis.Addr().String()
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	tlsConf, err := serverTLSConfig(&config.TLS{}, false)
+	assert.NoError(t, err)
+	assert.Nil(t, tlsConf)
+	_, err = serverTLSConfig(&config.TLS{ClientCA: "ca.pem"}, false)
+	assert.Error(t, err)
+	_, err = serverTLSConfig(&config.TLS{Cert: "not-exists.pem", Key: "not-exists.key"}, false)
+	assert.Error(t, err)
+
+	ca := newTestCA(t)
+	certFile, keyFile, _ := ca.issue(t, "server")
+	_, _, botA := ca.issue(t, "bot-a")
+	_, _, botB := ca.issue(t, "unknown", "bot-b.example.com")
+	_, _, other := ca.issue(t, "other")
+	caFile := filepath.Join(ca.dir, "ca.pem")
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	tokens := newAccessTokens(&config.MiddleWares{
+		AccessToken: "primary",
+		AccessTokens: []config.AccessToken{
+			{ClientCerts: []string{"bot-a"}, AllowActions: []string{"get_*"}},
+			{Token: "b", ClientCerts: []string{"bot-b.example.com"}},
+		},
+	})
+	withCA := runTLSServer(t, &config.TLS{Cert: certFile, Key: keyFile, ClientCA: caFile}, tokens)
+	withoutCA := runTLSServer(t, &config.TLS{Cert: certFile, Key: keyFile}, tokens)
+	requireCert := runTLSServer(t, &config.TLS{Cert: certFile, Key: keyFile, ClientCA: caFile}, nil)
+
+	var tests = [...]struct {
+		name   string
+		url    string
+		cert   *tls.Certificate
+		token  string
+		status int
+		scoped bool
+		err    bool
+	}{
+		{"mapped common name", withCA, &botA, "", http.StatusOK, true, false},
+		{"mapped dns name", withCA, &botB, "", http.StatusOK, false, false},
+		{"unmapped cert", withCA, &other, "", http.StatusUnauthorized, false, false},
+		{"unmapped cert with token", withCA, &other, "primary", http.StatusOK, false, false},
+		{"no cert", withCA, nil, "wrong", http.StatusForbidden, false, false},
+		{"cert without client-ca", withoutCA, &botA, "", http.StatusUnauthorized, false, false},
+		{"required cert", requireCert, &other, "", http.StatusOK, false, false},
+		{"missing required cert", requireCert, nil, "", 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &tls.Config{RootCAs: pool}
+			if tt.cert != nil {
+				c.Certificates = []tls.Certificate{*tt.cert}
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: c}, Timeout: time.Second * 5}
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp, err := client.Do(req)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.status, resp.StatusCode)
+			if tt.status == http.StatusOK {
+				var scoped bool
+				_, _ = fmt.Fscan(resp.Body, &scoped)
+				assert.Equal(t, tt.scoped, scoped)
+			}
+		})
+	}
+}
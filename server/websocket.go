@@ -3,16 +3,19 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Mrs4s/go-cqhttp/coolq"
 	"github.com/Mrs4s/go-cqhttp/global"
 	"github.com/Mrs4s/go-cqhttp/global/config"
+	"github.com/Mrs4s/go-cqhttp/global/metrics"
 
 	"github.com/Mrs4s/MiraiGo/utils"
 	"github.com/gorilla/websocket"
@@ -26,9 +29,12 @@ type webSocketServer struct {
 
 	eventConn      []*webSocketConn
 	eventConnMutex sync.Mutex
-	token          string
-	handshake      string
+	apiConn        map[*webSocketConn]struct{}
+	apiConnMutex   sync.Mutex
+	tokens         *accessTokens
+	handshakes     []string // 每个账号的 lifecycle/connect 事件
 	filter         string
+	v12            bool
 }
 
 // websocketClient WebSocket客户端实例
@@ -36,16 +42,59 @@ type websocketClient struct {
 	bot  *coolq.CQBot
 	conf *config.WebsocketReverse
 
-	universalConn *webSocketConn
-	eventConn     *webSocketConn
-	token         string
-	filter        string
+	universal *reverseTarget
+	api       *reverseTarget
+	event     *reverseTarget
+	stop      chan struct{}
+	token     string
+	scope     *tokenScope
+	tls       *certReloader // 连接 wss 地址时使用的证书, 未配置时为 nil
+	filter    string
+	v12       bool
+}
+
+// reverseTarget 反向WS的一个连接角色, 由 supervise 负责连接与重连
+type reverseTarget struct {
+	role string   // Universal, API 或 Event
+	urls []string // 依次尝试的地址, 第一个为主地址
+
+	lock    sync.Mutex
+	conn    *webSocketConn
+	pending [][]byte // 断开期间缓存的事件
+	dropped int      // 缓存已满时丢弃的事件数
 }
 
 type webSocketConn struct {
 	*websocket.Conn
 	sync.Mutex
 	apiCaller *apiCaller
+
+	labels []string // 连接数指标的标签
+	closed int32
+}
+
+// newWebSocketConn 包装一个 WebSocket 连接, typ 与 role 用于连接数统计
+func newWebSocketConn(conn *websocket.Conn, api *apiCaller, typ, role string) *webSocketConn {
+	c := &webSocketConn{Conn: conn, apiCaller: api, labels: []string{typ, role}}
+	metrics.WebSocketConnections.Inc(c.labels...)
+	return c
+}
+
+// Close 关闭连接, 重复调用时只统计一次
+func (c *webSocketConn) Close() error {
+	if atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
+		metrics.WebSocketConnections.Dec(c.labels...)
+	}
+	return c.Conn.Close()
+}
+
+// shutdown 发送关闭帧后关闭连接
+func (c *webSocketConn) shutdown() {
+	c.Lock()
+	_ = c.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "shutdown"),
+		time.Now().Add(time.Second))
+	c.Unlock()
+	_ = c.Close()
 }
 
 var upgrader = websocket.Upgrader{
@@ -54,164 +103,353 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// RunWebSocketServer 运行一个正向WS server
-func RunWebSocketServer(b *coolq.CQBot, conf *config.WebsocketServer) {
+// RunWebSocketServer 运行一个正向WS server, 返回的函数用于停止该服务器, 启动失败时返回 nil
+//
+// b 为 nil 时推送所有已登录账号的事件, API 调用按 self_id 参数或 X-Self-ID 请求头路由.
+func RunWebSocketServer(b *coolq.CQBot, conf *config.WebsocketServer) (stop func()) {
 	if conf.Disabled {
-		return
+		return func() {}
 	}
 	s := &webSocketServer{
-		bot:    b,
-		conf:   conf,
-		token:  conf.AccessToken,
-		filter: conf.Filter,
+		bot:     b,
+		conf:    conf,
+		apiConn: make(map[*webSocketConn]struct{}),
+		tokens:  newAccessTokens(&conf.MiddleWares),
+		filter:  conf.Filter,
+		v12:     isV12(conf.OneBotVersion),
 	}
 	addFilter(s.filter)
 	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
-	s.handshake = fmt.Sprintf(`{"_post_method":2,"meta_event_type":"lifecycle","post_type":"meta_event","self_id":%d,"sub_type":"connect","time":%d}`,
-		b.Client.Uin, time.Now().Unix())
-	b.OnEventPush(s.onBotPushEvent)
+	tlsConf, err := serverTLSConfig(&conf.TLS, s.tokens == nil)
+	if err != nil {
+		log.Errorf("读取 WebSocket 服务器 %v 的 TLS 证书失败, 服务器将不会启动: %v", addr, err)
+		return nil
+	}
+	lis, err := listen("WebSocket 服务", addr)
+	if err != nil {
+		return nil
+	}
+	var removes []func()
+	if s.v12 {
+		// v12 的 meta.connect 事件不属于某个账号, 仅发送一次
+		s.handshakes = []string{string(v12ConnectEvent())}
+	}
+	for _, bot := range botsOf(b) {
+		if !s.v12 {
+			s.handshakes = append(s.handshakes, fmt.Sprintf(`{"_post_method":2,"meta_event_type":"lifecycle","post_type":"meta_event","self_id":%d,"sub_type":"connect","time":%d}`,
+				bot.Client.Uin, time.Now().Unix()))
+		}
+		removes = append(removes, bot.OnEventPush(s.onBotPushEvent))
+	}
 	mux := http.ServeMux{}
 	mux.HandleFunc("/event", s.event)
 	mux.HandleFunc("/api", s.api)
 	mux.HandleFunc("/", s.any)
+	server := &http.Server{Addr: addr, Handler: &mux, TLSConfig: tlsConf}
 	go func() {
 		log.Infof("CQ WebSocket 服务器已启动: %v", addr)
-		log.Fatal(http.ListenAndServe(addr, &mux))
+		if err := serve(server, lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("WebSocket 服务器 %v 出现错误: %v", addr, err)
+		}
 	}()
+	return func() {
+		for _, remove := range removes {
+			remove()
+		}
+		_ = server.Close()
+		s.closeAll()
+		log.Infof("CQ WebSocket 服务器已停止: %v", addr)
+	}
 }
 
-// RunWebSocketClient 运行一个正向WS client
-func RunWebSocketClient(b *coolq.CQBot, conf *config.WebsocketReverse) {
+// closeAll 关闭所有已接受的连接
+func (s *webSocketServer) closeAll() {
+	s.eventConnMutex.Lock()
+	for _, conn := range s.eventConn {
+		conn.shutdown()
+	}
+	s.eventConn = nil
+	s.eventConnMutex.Unlock()
+	s.apiConnMutex.Lock()
+	for conn := range s.apiConn {
+		conn.shutdown()
+	}
+	s.apiConnMutex.Unlock()
+}
+
+// RunWebSocketClient 运行一个正向WS client, 返回的函数用于断开连接并停止重连, 读取 TLS 证书失败时返回 nil
+//
+// b 为 nil 时为每个已登录的账号分别建立连接.
+func RunWebSocketClient(b *coolq.CQBot, conf *config.WebsocketReverse) (stop func()) {
 	if conf.Disabled {
-		return
+		return func() {}
+	}
+	if b == nil {
+		var stops []func()
+		for _, bot := range botsOf(nil) {
+			stop := RunWebSocketClient(bot, conf)
+			if stop == nil {
+				return nil
+			}
+			stops = append(stops, stop)
+		}
+		return func() {
+			for _, f := range stops {
+				f()
+			}
+		}
 	}
 	c := &websocketClient{
 		bot:    b,
 		conf:   conf,
-		token:  conf.AccessToken,
+		stop:   make(chan struct{}),
 		filter: conf.Filter,
+		v12:    isV12(conf.OneBotVersion),
+	}
+	tokens := newAccessTokens(&conf.MiddleWares)
+	c.token = tokens.token()
+	c.scope = tokens.scope(c.token)
+	if conf.TLS != (config.TLS{}) {
+		r, err := newCertReloader(&conf.TLS)
+		if err != nil {
+			log.Errorf("读取反向WebSocket客户端的 TLS 证书失败, 客户端将不会启动: %v", err)
+			return nil
+		}
+		c.tls = r
 	}
 	addFilter(c.filter)
-	if c.conf.Universal != "" {
-		c.connectUniversal()
+	if conf.Universal != "" {
+		c.universal = newReverseTarget("Universal", conf.Universal, conf.Fallback.Universal)
 	} else {
-		if c.conf.API != "" {
-			c.connectAPI()
+		if conf.API != "" {
+			c.api = newReverseTarget("API", conf.API, conf.Fallback.API)
 		}
-		if c.conf.Event != "" {
-			c.connectEvent()
+		if conf.Event != "" {
+			c.event = newReverseTarget("Event", conf.Event, conf.Fallback.Event)
 		}
 	}
-	c.bot.OnEventPush(c.onBotPushEvent)
+	targets := c.targets()
+	for _, t := range targets {
+		go c.supervise(t)
+	}
+	remove := c.bot.OnEventPush(c.onBotPushEvent)
+	return func() {
+		close(c.stop)
+		remove()
+		for _, t := range targets {
+			t.close()
+		}
+		log.Infof("反向WebSocket客户端已停止: %v", c.conf.Universal+c.conf.API+c.conf.Event)
+	}
+}
+
+func newReverseTarget(role, url string, fallback []string) *reverseTarget {
+	return &reverseTarget{role: role, urls: append([]string{url}, fallback...)}
 }
 
-func (c *websocketClient) connectAPI() {
-	log.Infof("开始尝试连接到反向WebSocket API服务器: %v", c.conf.API)
+// targets 返回已配置的连接角色
+func (c *websocketClient) targets() []*reverseTarget {
+	var targets []*reverseTarget
+	for _, t := range []*reverseTarget{c.universal, c.api, c.event} {
+		if t != nil {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// header 连接反向WS服务器时使用的请求头
+func (c *websocketClient) header(role string) http.Header {
 	header := http.Header{
-		"X-Client-Role": []string{"API"},
+		"X-Client-Role": []string{role},
 		"X-Self-ID":     []string{strconv.FormatInt(c.bot.Client.Uin, 10)},
 		"User-Agent":    []string{"CQHttp/4.15.0"},
 	}
+	if c.v12 {
+		header["X-OneBot-Version"] = []string{"12"}
+		header["X-Impl"] = []string{v12Impl}
+		header["X-Platform"] = []string{v12Platform}
+	}
 	if c.token != "" {
 		header["Authorization"] = []string{"Token " + c.token}
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(c.conf.API, header) // nolint
-	if err != nil {
-		log.Warnf("连接到反向WebSocket API服务器 %v 时出现错误: %v", c.conf.API, err)
-		if c.conf.ReconnectInterval != 0 {
-			time.Sleep(time.Millisecond * time.Duration(c.conf.ReconnectInterval))
-			c.connectAPI()
-		}
-		return
+	return header
+}
+
+// handshake 连接建立后发送的 lifecycle/connect 事件
+func (c *websocketClient) handshake() []byte {
+	if c.v12 {
+		return v12ConnectEvent()
 	}
-	log.Infof("已连接到反向WebSocket API服务器 %v", c.conf.API)
-	wrappedConn := &webSocketConn{Conn: conn, apiCaller: newAPICaller(c.bot)}
-	if c.conf.RateLimit.Enabled {
-		wrappedConn.apiCaller.use(rateLimit(c.conf.RateLimit.Frequency, c.conf.RateLimit.Bucket))
+	return []byte(fmt.Sprintf(`{"meta_event_type":"lifecycle","post_type":"meta_event","self_id":%d,"sub_type":"connect","time":%d}`,
+		c.bot.Client.Uin, time.Now().Unix()))
+}
+
+// isStopped 客户端是否已停止, 停止后不再重连
+func (c *websocketClient) isStopped() bool {
+	select {
+	case <-c.stop:
+		return true
+	default:
+		return false
 	}
-	go c.listenAPI(wrappedConn, false)
 }
 
-func (c *websocketClient) connectEvent() {
-	log.Infof("开始尝试连接到反向WebSocket Event服务器: %v", c.conf.Event)
-	header := http.Header{
-		"X-Client-Role": []string{"Event"},
-		"X-Self-ID":     []string{strconv.FormatInt(c.bot.Client.Uin, 10)},
-		"User-Agent":    []string{"CQHttp/4.15.0"},
+// sleep 等待 d, 客户端在等待期间停止时返回 false
+func (c *websocketClient) sleep(d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-c.stop:
+		return false
+	case <-t.C:
+		return true
 	}
-	if c.token != "" {
-		header["Authorization"] = []string{"Token " + c.token}
+}
+
+// reconnectBackoff 带随机抖动的指数退避
+type reconnectBackoff struct {
+	base, max time.Duration
+	cur       time.Duration
+}
+
+func newReconnectBackoff(conf *config.WebsocketReverse) *reconnectBackoff {
+	b := &reconnectBackoff{
+		base: time.Millisecond * time.Duration(conf.ReconnectInterval),
+		max:  time.Millisecond * time.Duration(conf.MaxReconnectInterval),
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(c.conf.Event, header) // nolint
-	if err != nil {
-		log.Warnf("连接到反向WebSocket Event服务器 %v 时出现错误: %v", c.conf.Event, err)
-		if c.conf.ReconnectInterval != 0 {
-			time.Sleep(time.Millisecond * time.Duration(c.conf.ReconnectInterval))
-			c.connectEvent()
-		}
-		return
+	if b.max <= 0 {
+		b.max = time.Minute
+	}
+	if b.max < b.base {
+		b.max = b.base
 	}
+	return b
+}
 
-	handshake := fmt.Sprintf(`{"meta_event_type":"lifecycle","post_type":"meta_event","self_id":%d,"sub_type":"connect","time":%d}`,
-		c.bot.Client.Uin, time.Now().Unix())
-	err = conn.WriteMessage(websocket.TextMessage, []byte(handshake))
-	if err != nil {
-		log.Warnf("反向WebSocket 握手时出现错误: %v", err)
+// next 返回下一次重连前的等待时间, 在 [d/2, d] 中随机选择, 以免多个客户端同时重连
+func (b *reconnectBackoff) next() time.Duration {
+	d := b.cur
+	if d == 0 {
+		d = b.base
+	}
+	b.cur = d * 2
+	if b.cur > b.max {
+		b.cur = b.max
 	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
 
-	log.Infof("已连接到反向WebSocket Event服务器 %v", c.conf.Event)
-	c.eventConn = &webSocketConn{Conn: conn, apiCaller: newAPICaller(c.bot)}
+func (b *reconnectBackoff) reset() {
+	b.cur = 0
 }
 
-func (c *websocketClient) connectUniversal() {
-	log.Infof("开始尝试连接到反向WebSocket Universal服务器: %v", c.conf.Universal)
-	header := http.Header{
-		"X-Client-Role": []string{"Universal"},
-		"X-Self-ID":     []string{strconv.FormatInt(c.bot.Client.Uin, 10)},
-		"User-Agent":    []string{"CQHttp/4.15.0"},
+// supervise 维护 t 的连接直到客户端停止, 每个连接角色只有一个 supervise 协程
+//
+// reconnect-interval 为 0 时不重连.
+func (c *websocketClient) supervise(t *reverseTarget) {
+	backoff := newReconnectBackoff(c.conf)
+	for {
+		conn := c.dial(t, backoff)
+		if conn == nil {
+			return
+		}
+		start := time.Now()
+		c.serve(t, conn)
+		if c.isStopped() || c.conf.ReconnectInterval == 0 {
+			return
+		}
+		// 连接保持了足够长的时间才重置退避, 以免服务器接受连接后立即断开时频繁重连
+		if time.Since(start) > backoff.max {
+			backoff.reset()
+		}
+		wait := backoff.next()
+		log.Warnf("与反向WebSocket %v服务器的连接已断开, 将在 %v 后重连.", t.role, wait)
+		if !c.sleep(wait) {
+			return
+		}
 	}
-	if c.token != "" {
-		header["Authorization"] = []string{"Token " + c.token}
+}
+
+// dial 依次尝试 t 的各个地址, 全部失败后等待退避间隔再从主地址开始尝试
+//
+// 客户端停止, 或未启用重连且所有地址均无法连接时返回 nil.
+func (c *websocketClient) dial(t *reverseTarget, backoff *reconnectBackoff) *webSocketConn {
+	for {
+		for _, url := range t.urls {
+			if c.isStopped() {
+				return nil
+			}
+			log.Infof("开始尝试连接到反向WebSocket %v服务器: %v", t.role, url)
+			conn, _, err := c.dialer().Dial(url, c.header(t.role)) // nolint
+			if err != nil {
+				log.Warnf("连接到反向WebSocket %v服务器 %v 时出现错误: %v", t.role, url, err)
+				metrics.ReverseWebSocketDials.Inc(t.role, "failed")
+				continue
+			}
+			log.Infof("已连接到反向WebSocket %v服务器 %v", t.role, url)
+			metrics.ReverseWebSocketDials.Inc(t.role, "success")
+			wrappedConn := newWebSocketConn(conn, c.newAPICaller(), "ws-reverse", strings.ToLower(t.role))
+			if c.conf.RateLimit.Enabled {
+				wrappedConn.apiCaller.use(rateLimit(c.conf.RateLimit.Frequency, c.conf.RateLimit.Bucket))
+			}
+			return wrappedConn
+		}
+		if c.conf.ReconnectInterval == 0 || !c.sleep(backoff.next()) {
+			return nil
+		}
 	}
-	conn, _, err := websocket.DefaultDialer.Dial(c.conf.Universal, header) // nolint
-	if err != nil {
-		log.Warnf("连接到反向WebSocket Universal服务器 %v 时出现错误: %v", c.conf.Universal, err)
-		if c.conf.ReconnectInterval != 0 {
-			time.Sleep(time.Millisecond * time.Duration(c.conf.ReconnectInterval))
-			c.connectUniversal()
+}
+
+// dialer 返回连接使用的 Dialer, 每次连接读取最新的证书
+func (c *websocketClient) dialer() *websocket.Dialer {
+	if c.tls == nil {
+		return websocket.DefaultDialer
+	}
+	d := *websocket.DefaultDialer
+	d.TLSClientConfig = c.tls.clientConfig()
+	return &d
+}
+
+// serve 发送 lifecycle/connect 事件与断开期间缓存的事件, 然后监听连接直到断开
+func (c *websocketClient) serve(t *reverseTarget, conn *webSocketConn) {
+	if t.role != "API" {
+		if err := conn.writeMessage(c.handshake()); err != nil {
+			log.Warnf("反向WebSocket 握手时出现错误: %v", err)
+			_ = conn.Close()
+			return
 		}
-		return
 	}
-	handshake := fmt.Sprintf(`{"meta_event_type":"lifecycle","post_type":"meta_event","self_id":%d,"sub_type":"connect","time":%d}`,
-		c.bot.Client.Uin, time.Now().Unix())
-	err = conn.WriteMessage(websocket.TextMessage, []byte(handshake))
-	if err != nil {
-		log.Warnf("反向WebSocket 握手时出现错误: %v", err)
+	if !t.attach(conn, c.isStopped) {
+		return
 	}
+	c.listen(t, conn)
+	t.detach(conn)
+}
 
-	wrappedConn := &webSocketConn{Conn: conn, apiCaller: newAPICaller(c.bot)}
-	if c.conf.RateLimit.Enabled {
-		wrappedConn.apiCaller.use(rateLimit(c.conf.RateLimit.Frequency, c.conf.RateLimit.Bucket))
-	}
-	go c.listenAPI(wrappedConn, true)
-	c.universalConn = wrappedConn
+// newAPICaller 创建连接使用的 apiCaller
+func (c *websocketClient) newAPICaller() *apiCaller {
+	api := newAPICaller(c.bot)
+	api.v12 = c.v12
+	return api.withScope(c.scope)
 }
 
-func (c *websocketClient) listenAPI(conn *webSocketConn, u bool) {
-	defer func() { _ = conn.Close() }()
+// listen 读取连接中的消息直到连接断开, Event 连接中的消息将被忽略
+func (c *websocketClient) listen(t *reverseTarget, conn *webSocketConn) {
 	for {
 		buffer := global.NewBuffer()
-		t, reader, err := conn.NextReader()
-		if err != nil {
-			log.Warnf("监听反向WS API时出现错误: %v", err)
-			break
+		typ, reader, err := conn.NextReader()
+		if err == nil {
+			_, err = buffer.ReadFrom(reader)
 		}
-		_, err = buffer.ReadFrom(reader)
 		if err != nil {
-			log.Warnf("监听反向WS API时出现错误: %v", err)
-			break
+			global.PutBuffer(buffer)
+			if !c.isStopped() {
+				log.Warnf("监听反向WS %v时出现错误: %v", t.role, err)
+			}
+			return
 		}
-		if t == websocket.TextMessage {
+		if typ == websocket.TextMessage && t.role != "Event" {
 			go func(buffer *bytes.Buffer) {
 				defer global.PutBuffer(buffer)
 				conn.handleRequest(c.bot, buffer.Bytes())
@@ -220,44 +458,107 @@ func (c *websocketClient) listenAPI(conn *webSocketConn, u bool) {
 			global.PutBuffer(buffer)
 		}
 	}
-	if c.conf.ReconnectInterval != 0 {
-		time.Sleep(time.Millisecond * time.Duration(c.conf.ReconnectInterval))
-		if !u {
-			go c.connectAPI()
-		}
-	}
 }
 
 func (c *websocketClient) onBotPushEvent(e *coolq.Event) {
+	body := eventPayload(c.v12, e.JSONBytes())
 	filter := findFilter(c.filter)
-	if filter != nil && !filter.Eval(gjson.Parse(e.JSONString())) {
-		log.Debugf("上报Event %s 到 WS服务器 时被过滤.", e.JSONBytes())
+	if filter != nil && !filter.Eval(gjson.ParseBytes(body)) {
+		log.Debugf("上报Event %s 到 WS服务器 时被过滤.", body)
 		return
 	}
-	push := func(conn *webSocketConn, reconnect func()) {
-		log.Debugf("向WS服务器 %v 推送Event: %s", conn.RemoteAddr().String(), e.JSONBytes())
-		conn.Lock()
-		defer conn.Unlock()
-		_ = conn.SetWriteDeadline(time.Now().Add(time.Second * 15))
-		if err := conn.WriteMessage(websocket.TextMessage, e.JSONBytes()); err != nil {
-			log.Warnf("向WS服务器 %v 推送Event时出现错误: %v", conn.RemoteAddr().String(), err)
+	t := c.universal
+	if t == nil {
+		t = c.event
+	}
+	if t != nil {
+		t.push(body, c.conf.BufferSize)
+	}
+}
+
+// writeMessage 发送一条文本消息, 与 API 调用的响应互斥
+func (c *webSocketConn) writeMessage(body []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	_ = c.SetWriteDeadline(time.Now().Add(time.Second * 15))
+	return c.WriteMessage(websocket.TextMessage, body)
+}
+
+// attach 发送缓存的事件后将 conn 设为当前连接, 客户端已停止时关闭 conn 并返回 false
+func (t *reverseTarget) attach(conn *webSocketConn, stopped func() bool) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if stopped() {
+		conn.shutdown()
+		return false
+	}
+	if t.dropped > 0 {
+		log.Warnf("反向WebSocket %v 断开期间缓存的事件超过上限, 已丢弃最早的 %v 个事件.", t.role, t.dropped)
+		t.dropped = 0
+	}
+	if len(t.pending) > 0 {
+		log.Infof("正在向反向WebSocket %v服务器发送断开期间缓存的 %v 个事件.", t.role, len(t.pending))
+	}
+	for i, body := range t.pending {
+		if err := conn.writeMessage(body); err != nil {
+			log.Warnf("向WS服务器 %v 发送缓存的Event时出现错误: %v", conn.RemoteAddr().String(), err)
+			t.pending = t.pending[i:]
 			_ = conn.Close()
-			if c.conf.ReconnectInterval != 0 {
-				time.Sleep(time.Millisecond * time.Duration(c.conf.ReconnectInterval))
-				reconnect()
-			}
+			return true // 由 listen 检测到断开后重连
 		}
 	}
-	if c.eventConn != nil {
-		push(c.eventConn, c.connectEvent)
+	t.pending = nil
+	t.conn = conn
+	return true
+}
+
+// detach 连接断开后关闭 conn, 之后的事件将被缓存
+func (t *reverseTarget) detach(conn *webSocketConn) {
+	t.lock.Lock()
+	if t.conn == conn {
+		t.conn = nil
+	}
+	t.lock.Unlock()
+	_ = conn.Close()
+}
+
+// push 推送事件, 未连接或推送失败时缓存事件, 缓存已满时丢弃最早的事件
+func (t *reverseTarget) push(body []byte, bufferSize int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.conn != nil {
+		log.Debugf("向WS服务器 %v 推送Event: %s", t.conn.RemoteAddr().String(), body)
+		err := t.conn.writeMessage(body)
+		if err == nil {
+			return
+		}
+		log.Warnf("向WS服务器 %v 推送Event时出现错误: %v", t.conn.RemoteAddr().String(), err)
+		// 关闭连接后 listen 将返回, 由 supervise 负责重连
+		_ = t.conn.Close()
+		t.conn = nil
+	}
+	if bufferSize <= 0 {
+		return
 	}
-	if c.universalConn != nil {
-		push(c.universalConn, c.connectUniversal)
+	if len(t.pending) >= bufferSize {
+		t.pending = t.pending[1:]
+		t.dropped++
+	}
+	t.pending = append(t.pending, append([]byte(nil), body...))
+}
+
+// close 关闭当前连接
+func (t *reverseTarget) close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.conn != nil {
+		t.conn.shutdown()
+		t.conn = nil
 	}
 }
 
 func (s *webSocketServer) event(w http.ResponseWriter, r *http.Request) {
-	status := checkAuth(r, s.token)
+	status, scope := checkAuth(r, s.tokens)
 	if status != http.StatusOK {
 		log.Warnf("已拒绝 %v 的 WebSocket 请求: Token鉴权失败(code:%d)", r.RemoteAddr, status)
 		w.WriteHeader(status)
@@ -268,8 +569,7 @@ func (s *webSocketServer) event(w http.ResponseWriter, r *http.Request) {
 		log.Warnf("处理 WebSocket 请求时出现错误: %v", err)
 		return
 	}
-	err = c.WriteMessage(websocket.TextMessage, []byte(s.handshake))
-	if err != nil {
+	if err = s.sendHandshakes(c); err != nil {
 		log.Warnf("WebSocket 握手时出现错误: %v", err)
 		_ = c.Close()
 		return
@@ -277,15 +577,12 @@ func (s *webSocketServer) event(w http.ResponseWriter, r *http.Request) {
 
 	log.Infof("接受 WebSocket 连接: %v (/event)", r.RemoteAddr)
 
-	conn := &webSocketConn{Conn: c, apiCaller: newAPICaller(s.bot)}
-
-	s.eventConnMutex.Lock()
-	s.eventConn = append(s.eventConn, conn)
-	s.eventConnMutex.Unlock()
+	conn := newWebSocketConn(c, s.newAPICaller(r, scope), "ws", "event")
+	s.addEventConn(conn, r)
 }
 
 func (s *webSocketServer) api(w http.ResponseWriter, r *http.Request) {
-	status := checkAuth(r, s.token)
+	status, scope := checkAuth(r, s.tokens)
 	if status != http.StatusOK {
 		log.Warnf("已拒绝 %v 的 WebSocket 请求: Token鉴权失败(code:%d)", r.RemoteAddr, status)
 		w.WriteHeader(status)
@@ -297,15 +594,18 @@ func (s *webSocketServer) api(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	log.Infof("接受 WebSocket 连接: %v (/api)", r.RemoteAddr)
-	conn := &webSocketConn{Conn: c, apiCaller: newAPICaller(s.bot)}
+	conn := newWebSocketConn(c, s.newAPICaller(r, scope), "ws", "api")
 	if s.conf.RateLimit.Enabled {
 		conn.apiCaller.use(rateLimit(s.conf.RateLimit.Frequency, s.conf.RateLimit.Bucket))
 	}
+	s.apiConnMutex.Lock()
+	s.apiConn[conn] = struct{}{}
+	s.apiConnMutex.Unlock()
 	go s.listenAPI(conn)
 }
 
 func (s *webSocketServer) any(w http.ResponseWriter, r *http.Request) {
-	status := checkAuth(r, s.token)
+	status, scope := checkAuth(r, s.tokens)
 	if status != http.StatusOK {
 		log.Warnf("已拒绝 %v 的 WebSocket 请求: Token鉴权失败(code:%d)", r.RemoteAddr, status)
 		w.WriteHeader(status)
@@ -316,25 +616,135 @@ func (s *webSocketServer) any(w http.ResponseWriter, r *http.Request) {
 		log.Warnf("处理 WebSocket 请求时出现错误: %v", err)
 		return
 	}
-	err = c.WriteMessage(websocket.TextMessage, []byte(s.handshake))
-	if err != nil {
+	if err = s.sendHandshakes(c); err != nil {
 		log.Warnf("WebSocket 握手时出现错误: %v", err)
 		_ = c.Close()
 		return
 	}
 	log.Infof("接受 WebSocket 连接: %v (/)", r.RemoteAddr)
-	conn := &webSocketConn{Conn: c, apiCaller: newAPICaller(s.bot)}
+	conn := newWebSocketConn(c, s.newAPICaller(r, scope), "ws", "universal")
 	if s.conf.RateLimit.Enabled {
 		conn.apiCaller.use(rateLimit(s.conf.RateLimit.Frequency, s.conf.RateLimit.Bucket))
 	}
+	if !s.addEventConn(conn, r) {
+		return
+	}
+	s.listenAPI(conn)
+}
+
+// newAPICaller 创建连接 r 使用的 apiCaller
+func (s *webSocketServer) newAPICaller(r *http.Request, scope *tokenScope) *apiCaller {
+	api := newAPICaller(s.bot)
+	api.v12 = s.v12
+	return api.withScope(scope).withSelfID(parseSelfID(r.Header.Get("X-Self-ID")))
+}
+
+// sendHandshakes 向新连接发送每个账号的 lifecycle/connect 事件
+func (s *webSocketServer) sendHandshakes(c *websocket.Conn) error {
+	for _, handshake := range s.handshakes {
+		if err := c.WriteMessage(websocket.TextMessage, []byte(handshake)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayWriteTimeout 重放单个事件的写超时
+const replayWriteTimeout = time.Second * 10
+
+// addEventConn 按连接参数重放事件后开始向 conn 推送事件, 重放失败时关闭连接并返回 false
+//
+// 事件日志中的大部分事件在不持有 eventConnMutex 时重放, 以免阻塞其他连接的推送;
+// 追上日志后持有 eventConnMutex 补发期间新增的少量事件, 再加入推送列表, 保证不会漏掉事件.
+// 补发期间分发的事件可能会被重复推送, 客户端应根据 event_seq 去重.
+func (s *webSocketServer) addEventConn(conn *webSocketConn, r *http.Request) bool {
+	replay := s.newEventReplay(conn, r)
+	if replay != nil && !replay.run() {
+		_ = conn.Close()
+		return false
+	}
 	s.eventConnMutex.Lock()
+	defer s.eventConnMutex.Unlock()
+	if replay != nil {
+		if !replay.run() {
+			_ = conn.Close()
+			return false
+		}
+		log.Infof("已向WS客户端 %v 重放 %v 个事件.", conn.RemoteAddr(), replay.count)
+	}
 	s.eventConn = append(s.eventConn, conn)
-	s.eventConnMutex.Unlock()
-	s.listenAPI(conn)
+	return true
+}
+
+// eventReplay 向新连接重放事件日志中的事件
+type eventReplay struct {
+	conn   *webSocketConn
+	bot    *coolq.CQBot
+	seq    uint64
+	since  int64
+	filter global.Filter
+	v12    bool
+	count  int
+}
+
+// newEventReplay 根据连接参数 seq 或 since 创建重放, 未请求重放或无法重放时返回 nil
+//
+// 多账号共享时重放 X-Self-ID 请求头指定的账号, 未指定时为默认账号.
+func (s *webSocketServer) newEventReplay(conn *webSocketConn, r *http.Request) *eventReplay {
+	query := r.URL.Query()
+	if query.Get("seq") == "" && query.Get("since") == "" {
+		return nil
+	}
+	bot := s.bot
+	if bot == nil {
+		if bot = findBot(parseSelfID(r.Header.Get("X-Self-ID"))); bot == nil {
+			return nil
+		}
+	}
+	if !bot.JournalEnabled() {
+		log.Warnf("WebSocket 客户端 %v 请求重放事件, 但事件日志未启用.", conn.RemoteAddr())
+		return nil
+	}
+	rp := &eventReplay{conn: conn, bot: bot, filter: findFilter(s.filter), v12: s.v12}
+	rp.seq, _ = strconv.ParseUint(query.Get("seq"), 10, 64)
+	rp.since, _ = strconv.ParseInt(query.Get("since"), 10, 64)
+	return rp
+}
+
+// run 发送上次发送之后的所有事件, 直到追上事件日志, 写入失败时返回 false
+func (rp *eventReplay) run() bool {
+	for {
+		events, _ := rp.bot.EventsSince(rp.seq, rp.since, 1000)
+		if len(events) == 0 {
+			return true
+		}
+		for _, e := range events {
+			rp.seq = gjson.GetBytes(e, "event_seq").Uint()
+			e = eventPayload(rp.v12, e)
+			if rp.filter != nil && !rp.filter.Eval(gjson.ParseBytes(e)) {
+				continue
+			}
+			rp.conn.Lock()
+			_ = rp.conn.SetWriteDeadline(time.Now().Add(replayWriteTimeout))
+			err := rp.conn.WriteMessage(websocket.TextMessage, e)
+			_ = rp.conn.SetWriteDeadline(time.Time{})
+			rp.conn.Unlock()
+			if err != nil {
+				log.Warnf("向WS客户端 %v 重放事件时出现错误: %v", rp.conn.RemoteAddr(), err)
+				return false
+			}
+			rp.count++
+		}
+	}
 }
 
 func (s *webSocketServer) listenAPI(c *webSocketConn) {
-	defer func() { _ = c.Close() }()
+	defer func() {
+		s.apiConnMutex.Lock()
+		delete(s.apiConn, c)
+		s.apiConnMutex.Unlock()
+		_ = c.Close()
+	}()
 	for {
 		buffer := global.NewBuffer()
 		t, reader, err := c.NextReader()
@@ -365,9 +775,14 @@ func (c *webSocketConn) handleRequest(_ *coolq.CQBot, payload []byte) {
 		}
 	}()
 	j := gjson.Parse(utils.B2S(payload))
-	t := strings.TrimSuffix(j.Get("action").Str, "_async")
-	log.Debugf("WS接收到API调用: %v 参数: %v", t, j.Get("params").Raw)
-	ret := c.apiCaller.callAPI(t, j.Get("params"))
+	t := j.Get("action").Str
+	fields := log.Fields{"request_id": newRandomID()}
+	if echo := j.Get("echo"); echo.Exists() {
+		fields["echo"] = echo.Value()
+	}
+	api := c.apiCaller.withLog(fields)
+	api.logger().Debugf("WS接收到API调用: %v 参数: %v", t, j.Get("params").Raw)
+	ret := api.callAPI(t, j.Get("params"))
 	if j.Get("echo").Exists() {
 		ret["echo"] = j.Get("echo").Value()
 	}
@@ -380,17 +795,18 @@ func (s *webSocketServer) onBotPushEvent(e *coolq.Event) {
 	s.eventConnMutex.Lock()
 	defer s.eventConnMutex.Unlock()
 
+	body := eventPayload(s.v12, e.JSONBytes())
 	filter := findFilter(s.filter)
-	if filter != nil && !filter.Eval(gjson.Parse(e.JSONString())) {
-		log.Debugf("上报Event %s 到 WS客户端 时被过滤.", e.JSONBytes())
+	if filter != nil && !filter.Eval(gjson.ParseBytes(body)) {
+		log.Debugf("上报Event %s 到 WS客户端 时被过滤.", body)
 		return
 	}
 	j := 0
 	for i := 0; i < len(s.eventConn); i++ {
 		conn := s.eventConn[i]
-		log.Debugf("向WS客户端 %v 推送Event: %s", conn.RemoteAddr().String(), e.JSONBytes())
+		log.Debugf("向WS客户端 %v 推送Event: %s", conn.RemoteAddr().String(), body)
 		conn.Lock()
-		if err := conn.WriteMessage(websocket.TextMessage, e.JSONBytes()); err != nil {
+		if err := conn.WriteMessage(websocket.TextMessage, body); err != nil {
 			_ = conn.Close()
 			conn = nil
 			continue
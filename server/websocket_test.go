@@ -0,0 +1,166 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Mrs4s/MiraiGo/client"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/Mrs4s/go-cqhttp/coolq"
+	"github.com/Mrs4s/go-cqhttp/global/config"
+)
+
+func TestReconnectBackoff(t *testing.T) {
+	var tests = [...]struct {
+		interval, max int // 单位毫秒
+		expected      []time.Duration
+	}{
+		{1000, 0, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second, time.Minute, time.Minute}},
+		{1000, 5000, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}},
+		{3000, 1000, []time.Duration{3 * time.Second, 3 * time.Second}},
+		{0, 5000, []time.Duration{0, 0}},
+	}
+	for i := 0; i < len(tests); i++ {
+		t.Run("test case "+strconv.Itoa(i), func(t *testing.T) {
+			b := newReconnectBackoff(&config.WebsocketReverse{ReconnectInterval: tests[i].interval, MaxReconnectInterval: tests[i].max})
+			for _, d := range tests[i].expected {
+				wait := b.next()
+				assert.GreaterOrEqual(t, int64(wait), int64(d/2))
+				assert.LessOrEqual(t, int64(wait), int64(d))
+			}
+			b.reset()
+			assert.LessOrEqual(t, int64(b.next()), int64(tests[i].expected[0]))
+		})
+	}
+}
+
+// wsRecorder 记录收到的消息的 WebSocket 服务器, refuse 不为 0 时拒绝连接
+type wsRecorder struct {
+	srv    *httptest.Server
+	refuse int32
+	dials  int32
+	msgs   chan string
+
+	lock  sync.Mutex
+	conns []*websocket.Conn
+}
+
+func newWSRecorder(t *testing.T, refuse bool) *wsRecorder {
+	r := &wsRecorder{msgs: make(chan string, 16)}
+	if refuse {
+		r.refuse = 1
+	}
+	r.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&r.dials, 1)
+		if atomic.LoadInt32(&r.refuse) != 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		r.lock.Lock()
+		r.conns = append(r.conns, conn)
+		r.lock.Unlock()
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			r.msgs <- string(msg)
+		}
+	}))
+	t.Cleanup(r.srv.Close)
+	return r
+}
+
+func (r *wsRecorder) url() string {
+	return "ws" + strings.TrimPrefix(r.srv.URL, "http")
+}
+
+// down 拒绝之后的连接并断开已有的连接
+func (r *wsRecorder) down() {
+	atomic.StoreInt32(&r.refuse, 1)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, conn := range r.conns {
+		_ = conn.Close()
+	}
+	r.conns = nil
+}
+
+func (r *wsRecorder) next(t *testing.T) gjson.Result {
+	select {
+	case msg := <-r.msgs:
+		return gjson.Parse(msg)
+	case <-time.After(time.Second * 5):
+		t.Fatal("等待消息超时")
+		return gjson.Result{}
+	}
+}
+
+func TestReverseFailover(t *testing.T) {
+	primary := newWSRecorder(t, true)
+	fallback1 := newWSRecorder(t, false)
+	fallback2 := newWSRecorder(t, false)
+	c := &websocketClient{
+		bot:  &coolq.CQBot{Client: &client.QQClient{Uin: 1}},
+		conf: &config.WebsocketReverse{ReconnectInterval: 100, MaxReconnectInterval: 200, BufferSize: 2},
+		stop: make(chan struct{}),
+	}
+	target := newReverseTarget("Universal", primary.url(), []string{fallback1.url(), fallback2.url()})
+	c.universal = target
+	go c.supervise(target)
+	defer func() {
+		close(c.stop)
+		target.close()
+	}()
+	push := func(n int) {
+		c.onBotPushEvent(&coolq.Event{RawMsg: coolq.MSG{"post_type": "message", "n": n}})
+	}
+	detached := func() bool {
+		target.lock.Lock()
+		defer target.lock.Unlock()
+		return target.conn == nil
+	}
+
+	// 主地址不可用时连接到第一个备用地址
+	assert.Equal(t, "connect", fallback1.next(t).Get("sub_type").String())
+	assert.Eventually(t, func() bool { return !detached() }, time.Second*5, time.Millisecond*10)
+	push(0)
+	assert.Equal(t, int64(0), fallback1.next(t).Get("n").Int())
+
+	// 断开期间缓存的事件超出 buffer-size 时丢弃最早的事件
+	fallback1.down()
+	assert.Eventually(t, detached, time.Second*5, time.Millisecond*10)
+	for i := 1; i <= 3; i++ {
+		push(i)
+	}
+
+	// 依次尝试主地址与第一个备用地址后连接到第二个备用地址, 重新发送 connect 后发送缓存的事件
+	assert.Equal(t, "connect", fallback2.next(t).Get("sub_type").String())
+	assert.Equal(t, int64(2), fallback2.next(t).Get("n").Int())
+	assert.Equal(t, int64(3), fallback2.next(t).Get("n").Int())
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&primary.dials), int32(2))
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&fallback1.dials), int32(2))
+
+	// 重连时优先连接主地址
+	atomic.StoreInt32(&primary.refuse, 0)
+	fallback2.down()
+	assert.Equal(t, "connect", primary.next(t).Get("sub_type").String())
+	assert.Eventually(t, func() bool { return !detached() }, time.Second*5, time.Millisecond*10)
+	push(4)
+	assert.Equal(t, int64(4), primary.next(t).Get("n").Int())
+	assert.Empty(t, fallback1.msgs)
+	assert.Empty(t, fallback2.msgs)
+}